@@ -4,11 +4,7 @@
  * headers. the custom logic in this file has "MARK" comments
  */
 
-import {
-  clearGolfAuthTOken as clearGolfAuthToken,
-  getGolfAuthToken,
-  setGolfAuthToken,
-} from "../session";
+import { clearCsrfToken, csrfHeaderName, getCsrfToken, getSession } from "../session";
 
 export type GolfFetcherExtraProps = {
   /**
@@ -84,23 +80,28 @@ export async function golfFetch<
 }: GolfFetcherOptions<TBody, THeaders, TQueryParams, TPathParams>): Promise<TData> {
   let error: ErrorWrapper<TError>;
   try {
-    // MARK: auth token lookup
-    const authToken = getGolfAuthToken();
-    if (!authToken) {
+    // MARK: csrf token lookup
+    // the session cookie is sent by the browser; the csrf token proves that the
+    // request comes from this page and not from some other site
+    if (!getCsrfToken() && !(await getSession())) {
       // hard navigate home. it would be nice to use react-router client-side
       // navigation, but it seems like even in the best case where we could
       // return something that could then turn into a call to redirect() or
       // navigate() in a loader or component, we would still have to check for
       // that in the loaders and components every time an api call is made. hard
       // navigation is probably fine.
-      console.warn("no auth token found, redirecting home");
+      console.warn("not logged in, redirecting home");
       window.location.href = "/";
     }
-    const authHeader = { Authorization: `Bearer ${authToken}` };
+    const csrfHeader: Record<string, string> = {};
+    const csrfToken = getCsrfToken();
+    if (csrfToken) {
+      csrfHeader[csrfHeaderName] = csrfToken;
+    }
 
     const requestHeaders: HeadersInit = {
       "Content-Type": "application/json",
-      ...authHeader,
+      ...csrfHeader,
       ...headers,
     };
 
@@ -126,6 +127,7 @@ export async function golfFetch<
 
     const response = await window.fetch(`${baseUrl}${resolveUrl(url, queryParams, pathParams)}`, {
       signal,
+      credentials: "same-origin",
       method: method.toUpperCase(),
       body: resolvedBody
         ? resolvedBody instanceof FormData
@@ -137,10 +139,10 @@ export async function golfFetch<
     if (!response.ok) {
       // MARK: redirect on 401
       if (response.status === 401) {
-        // same comment as on auth token check. TODO: add a query parameter that
+        // same comment as on csrf token check. TODO: add a query parameter that
         // specifies the error and display it on the login page in red text
-        console.warn("got 401 response, erasing token and redirecting home");
-        clearGolfAuthToken();
+        console.warn("got 401 response, erasing csrf token and redirecting home");
+        clearCsrfToken();
         window.location.href = "/";
       }
 
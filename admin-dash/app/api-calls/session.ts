@@ -1,14 +1,70 @@
 /**
- * Code to manage the client-side session state, which is currently literally
- * just an auth token in localStorage. The flow is as follows: the token is set
- * on the login page; it is gotten in presumably every clientLoader in order to
- * make API requests; it is cleared in the API caller function golfFetch if it
- * ever produces a 401 Unauthorized response. TODO: add "log out" button
- * somewhere that also clears it
+ * Code to manage the client-side session state. The session itself is kept in
+ * an HTTP-only cookie that the admin API sets on login, so it can't be read
+ * from here; the only thing stored client-side is the session's CSRF token,
+ * which has to be sent with every request that changes something. The flow is
+ * as follows: the cookie and token are gotten on the login page; the token is
+ * sent by the API caller function golfFetch with every request; the token is
+ * cleared in golfFetch if a request ever produces a 401 Unauthorized response,
+ * or when logging out.
  */
 
-export const golfAuthTokenKey = "golf:authToken";
-export const setGolfAuthToken = (newToken: string) =>
-  localStorage.setItem(golfAuthTokenKey, newToken);
-export const getGolfAuthToken = () => localStorage.getItem(golfAuthTokenKey);
-export const clearGolfAuthTOken = () => localStorage.removeItem(golfAuthTokenKey);
+const baseUrl = "/_golf";
+
+export const csrfTokenKey = "golf:csrfToken";
+export const csrfHeaderName = "X-Golf-CSRF";
+
+export type GolfSession = {
+  identity: string;
+  // only present if the request was authenticated with the session cookie
+  // (and not, for example, by coming from localhost)
+  csrfToken?: string;
+  expiresAt?: string;
+};
+
+// sessionStorage is per-tab, so new tabs get the token again from getSession
+export const setCsrfToken = (newToken: string) => sessionStorage.setItem(csrfTokenKey, newToken);
+export const getCsrfToken = () => sessionStorage.getItem(csrfTokenKey);
+export const clearCsrfToken = () => sessionStorage.removeItem(csrfTokenKey);
+
+const readSession = async (response: Response): Promise<GolfSession> => {
+  const session: GolfSession = await response.json();
+  if (session.csrfToken) {
+    setCsrfToken(session.csrfToken);
+  }
+  return session;
+};
+
+/** Starts a session with a token from `golf create-token`. */
+export const login = async (token: string): Promise<GolfSession> => {
+  const response = await fetch(`${baseUrl}/session/login`, {
+    method: "POST",
+    credentials: "same-origin",
+    headers: { "Content-Type": "application/json" },
+    body: JSON.stringify({ token }),
+  });
+  if (!response.ok) {
+    const problem = await response.json().catch(() => ({}));
+    throw new Error(problem.detail ?? `Login failed (${response.status})`);
+  }
+  return readSession(response);
+};
+
+/** Returns the current session, or null if the user isn't logged in. */
+export const getSession = async (): Promise<GolfSession | null> => {
+  const response = await fetch(`${baseUrl}/session`, { credentials: "same-origin" });
+  if (!response.ok) {
+    clearCsrfToken();
+    return null;
+  }
+  return readSession(response);
+};
+
+export const logout = async () => {
+  await fetch(`${baseUrl}/session/logout`, {
+    method: "POST",
+    credentials: "same-origin",
+    headers: { [csrfHeaderName]: getCsrfToken() ?? "" },
+  });
+  clearCsrfToken();
+};
@@ -4,7 +4,7 @@ import { Button, Form, Input, Modal, Typography } from "antd";
 
 import { useState } from "react";
 import { redirect, useNavigate } from "react-router";
-import { getGolfAuthToken, setGolfAuthToken } from "~/api-calls/session";
+import { getSession, login as startSession } from "~/api-calls/session";
 
 import ColorScheme from "~/components/ColorScheme";
 
@@ -18,14 +18,10 @@ export function meta({}: Route.MetaArgs) {
   return [{ title: "Internet Golf Admin Dashboard", description: "Manage your server." }];
 }
 
-export function clientLoader() {
-  // if the user already has a token stored, send them onward. if the token has
-  // been invalidated since it was entered, then golfFetcher will receive a 401
-  // Unauthorized response the next time it tries to make a request and then
-  // will send them right back here, so this might not "work"; however,
-  // attempting to forward them to the next page by default prioritizes the
-  // common, successful case
-  if (getGolfAuthToken()) {
+export async function clientLoader() {
+  // if the user already has a session (or is on the same machine as the
+  // server, which the server trusts), send them onward
+  if (await getSession()) {
     return redirect("/deployments");
   }
 }
@@ -34,16 +30,21 @@ export default function Login() {
   let navigate = useNavigate();
 
   const [isHelpModalOpen, setIsHelpModalOpen] = useState(false);
+  const [loginError, setLoginError] = useState<string | null>(null);
 
   const login = async ({ token }: LoginFormValues) => {
     if (!token) {
       console.error("no token");
       return;
     }
-    // TODO: some kind of call to like a "/validate-auth" endpoint should be
-    // made here before accepting and storing the token and forwarding the user;
-    // it would speed up the process of finding out the token is wrong
-    setGolfAuthToken(token);
+    // the server checks the token before starting a session, so a wrong token
+    // is caught here instead of on the next page
+    try {
+      await startSession(token);
+    } catch (e) {
+      setLoginError(e instanceof Error ? e.message : "Login failed");
+      return;
+    }
     navigate("/deployments");
   };
 
@@ -70,6 +71,7 @@ export default function Login() {
                 placeholder="Enter authentication token..."
               />
             </FormItem>
+            {loginError && <Typography.Text type="danger">{loginError}</Typography.Text>}
             <FormItem>
               <Button type="primary" htmlType="submit">
                 Login
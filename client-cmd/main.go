@@ -31,17 +31,105 @@ var ctx context.Context
 var createDeploymentGlobalFlags createDeploymentFlags
 
 type createDeploymentFlags struct {
-	github string
-	name   string
+	github          string
+	repo            string
+	repoSource      string
+	name            string
+	previewDomain   string
+	previewTtl      string
+	securityHeaders []string
+	setHeaders      []string
+	addHeaders      []string
+	deleteHeaders   []string
+	errorPages      map[string]string
+	basicAuthUsers  []string
+	allowedIps      []string
+	deniedIps       []string
+	shareToken      string
 }
 
 func addCreateDeploymentFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(
 		&createDeploymentGlobalFlags.github, "github", "", "Associate a Github repo with this deployment. Format: repoOwner/repoName[#branch]",
 	)
+	cmd.Flags().StringVar(
+		&createDeploymentGlobalFlags.repo, "repo", "",
+		"Associate a repo from somewhere other than Github with this deployment. Format: repoPath[#branch]. Needs --repo-source",
+	)
+	cmd.Flags().StringVar(
+		&createDeploymentGlobalFlags.repoSource, "repo-source", "",
+		"Where the repo from --repo lives. This has to be the name of one of the server's OIDC issuers, like \"Gitlab\"",
+	)
 	cmd.Flags().StringVar(
 		&createDeploymentGlobalFlags.name, "name", "", "Give your deployment a name. This is optional metadata; you can make it whatever you want.",
 	)
+	cmd.Flags().StringVar(
+		&createDeploymentGlobalFlags.previewDomain, "preview-domain", "", "Allow preview deployments of this deployment at subdomains of this domain, like pr-123.[preview domain].",
+	)
+	cmd.Flags().StringVar(
+		&createDeploymentGlobalFlags.previewTtl, "preview-ttl", "", "How long preview deployments last before they're deleted, like \"72h\". Defaults to one week.",
+	)
+	cmd.Flags().StringSliceVar(
+		&createDeploymentGlobalFlags.securityHeaders, "security-headers", []string{},
+		"Presets for common security headers to add to responses. Options: hsts, csp, frame-options, content-type-options, referrer-policy.",
+	)
+	cmd.Flags().StringArrayVar(
+		&createDeploymentGlobalFlags.setHeaders, "set-header", []string{},
+		"Set a response header, like \"Cache-Control: max-age=3600\". Put a path glob and a space in front to limit it to some paths, "+
+			"like \"/assets/* Cache-Control: max-age=31536000\". Can be used more than once.",
+	)
+	cmd.Flags().StringArrayVar(
+		&createDeploymentGlobalFlags.addHeaders, "add-header", []string{},
+		"Add a value for a response header without replacing existing ones. Same format as --set-header.",
+	)
+	cmd.Flags().StringArrayVar(
+		&createDeploymentGlobalFlags.deleteHeaders, "delete-header", []string{},
+		"Delete a response header, like \"Server\". Can also have a path glob in front, like \"/api/* Server\".",
+	)
+	cmd.Flags().StringToStringVar(
+		&createDeploymentGlobalFlags.errorPages, "error-page", map[string]string{},
+		"Show a page from the deployment's files for an error status, like \"404=/404.html\". "+
+			"The status can also be a range, like \"5xx=/50x.html\". Can be used more than once.",
+	)
+	cmd.Flags().StringArrayVar(
+		&createDeploymentGlobalFlags.basicAuthUsers, "basic-auth", []string{},
+		"Require a username and password to view the deployment, given as \"username:password\". Can be used more than once to add more users.",
+	)
+	cmd.Flags().StringSliceVar(
+		&createDeploymentGlobalFlags.allowedIps, "allow-ip", []string{},
+		"Only allow requests from these IP addresses or CIDR ranges, like \"192.168.0.0/16\".",
+	)
+	cmd.Flags().StringSliceVar(
+		&createDeploymentGlobalFlags.deniedIps, "deny-ip", []string{},
+		"Block requests from these IP addresses or CIDR ranges.",
+	)
+	cmd.Flags().StringVar(
+		&createDeploymentGlobalFlags.shareToken, "share-token", "",
+		"Make the deployment private, except to people with a link that has \"?golf_share=[token]\" at the end. "+
+			"The token has to be at least 16 characters long.",
+	)
+}
+
+// parses a header rule from a cli flag in the format "[path glob ]Name[: value]"
+func parseHeaderRule(operation string, flag string) golfsdk.HeaderRuleModel {
+	rule := golfsdk.HeaderRuleModel{Operation: operation}
+	if strings.HasPrefix(flag, "/") || strings.HasPrefix(flag, "*") {
+		path, rest, _ := strings.Cut(flag, " ")
+		rule.Path = &path
+		flag = strings.TrimSpace(rest)
+	}
+	if operation == "delete" {
+		rule.Name = flag
+		return rule
+	}
+	name, value, found := strings.Cut(flag, ":")
+	if !found {
+		exit1(fmt.Sprintf("header \"%s\" should have the format \"Name: value\"", flag))
+	}
+	rule.Name = strings.TrimSpace(name)
+	value = strings.TrimSpace(value)
+	rule.Value = &value
+	return rule
 }
 
 func createDeploymentInputBody(url string, flags *createDeploymentFlags) golfsdk.DeploymentCreateInputBody {
@@ -56,11 +144,60 @@ func createDeploymentInputBody(url string, flags *createDeploymentFlags) golfsdk
 		// case they'll be left out of the json request body)
 		externalSourceType = &githubSource
 		externalSource = &flags.github
+	} else if flags != nil && len(flags.repo) > 0 {
+		if len(flags.repoSource) == 0 {
+			exit1("--repo needs --repo-source to say where the repo lives")
+		}
+		externalSourceType = &flags.repoSource
+		externalSource = &flags.repo
+	}
+
+	var name *string
+	if flags != nil && len(flags.name) > 0 {
+		name = &flags.name
 	}
 
-	name := ""
+	var previewDomain *string
+	var previewTtl *string
+	if flags != nil && len(flags.previewDomain) > 0 {
+		previewDomain = &flags.previewDomain
+	}
+	if flags != nil && len(flags.previewTtl) > 0 {
+		previewTtl = &flags.previewTtl
+	}
+
+	var securityHeaders []string
+	var errorPages map[string]string
+	var allowedIps, deniedIps []string
+	var shareToken *string
+	headerRules := []golfsdk.HeaderRuleModel{}
+	basicAuthUsers := []golfsdk.BasicAuthUserModel{}
 	if flags != nil {
-		name = flags.name
+		securityHeaders = flags.securityHeaders
+		errorPages = flags.errorPages
+		allowedIps = flags.allowedIps
+		deniedIps = flags.deniedIps
+		if len(flags.shareToken) > 0 {
+			shareToken = &flags.shareToken
+		}
+		for _, user := range flags.basicAuthUsers {
+			username, password, found := strings.Cut(user, ":")
+			if !found {
+				exit1(fmt.Sprintf("basic auth user \"%s\" should have the format \"username:password\"", username))
+			}
+			basicAuthUsers = append(basicAuthUsers, golfsdk.BasicAuthUserModel{
+				Username: username, Password: &password,
+			})
+		}
+		for _, header := range flags.setHeaders {
+			headerRules = append(headerRules, parseHeaderRule("set", header))
+		}
+		for _, header := range flags.addHeaders {
+			headerRules = append(headerRules, parseHeaderRule("add", header))
+		}
+		for _, header := range flags.deleteHeaders {
+			headerRules = append(headerRules, parseHeaderRule("delete", header))
+		}
 	}
 
 	return golfsdk.DeploymentCreateInputBody{
@@ -69,6 +206,15 @@ func createDeploymentInputBody(url string, flags *createDeploymentFlags) golfsdk
 		ExternalSource:     externalSource,
 		Tags:               []string{},
 		Name:               name,
+		PreviewDomain:      previewDomain,
+		PreviewTtl:         previewTtl,
+		SecurityHeaders:    securityHeaders,
+		HeaderRules:        headerRules,
+		ErrorPages:         errorPages,
+		BasicAuthUsers:     basicAuthUsers,
+		AllowedIps:         allowedIps,
+		DeniedIps:          deniedIps,
+		ShareToken:         shareToken,
 	}
 }
 
@@ -110,6 +256,13 @@ func handleResponse(body successBody, resp *http.Response, respError error) {
 		exit1("Request failed")
 	}
 	fmt.Println(body.GetMessage())
+	// some responses come with problems that weren't bad enough to fail the
+	// request over, like mistakes in a site's _redirects file
+	if withWarnings, ok := body.(interface{ GetWarnings() []string }); ok {
+		for _, warning := range withWarnings.GetWarnings() {
+			fmt.Fprintln(os.Stderr, "Warning:", warning)
+		}
+	}
 }
 
 func createClient(hostnameFromTargetDeployment string) *golfsdk.APIClient {
@@ -166,10 +319,27 @@ func createClient(hostnameFromTargetDeployment string) *golfsdk.APIClient {
 		}
 		json.Unmarshal(oidcTokenJson, &oidcTokenData)
 		authHeader = "GithubOIDC " + strings.Trim(string(oidcTokenData.Value), " \n\r")
+	} else if oidcToken, isOidc := strings.CutPrefix(auth, "oidc:"); isOidc {
+		// for ci systems that put an id token in an environment variable,
+		// like gitlab ci with id_tokens
+		authHeader = "OIDC " + strings.TrimSpace(oidcToken)
 	} else if len(auth) > 0 {
 		authHeader = "Bearer " + auth
 	}
 
+	// the server can also listen on a unix socket, for clients on the same
+	// machine (or in containers that share the socket's directory)
+	httpClient := http.DefaultClient
+	if socketPath, isSocket := strings.CutPrefix(resolvedApiUrl, "unix://"); isSocket {
+		httpClient = &http.Client{Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		}}
+		// the host doesn't matter, since every request goes to the socket
+		resolvedApiUrl = "http://golf-server"
+	}
+
 	client := golfsdk.NewAPIClient(&golfsdk.Configuration{
 		UserAgent: "InternetGolfClient",
 		DefaultHeader: map[string]string{
@@ -178,6 +348,7 @@ func createClient(hostnameFromTargetDeployment string) *golfsdk.APIClient {
 		Servers: golfsdk.ServerConfigurations{
 			{URL: resolvedApiUrl},
 		},
+		HTTPClient: httpClient,
 	})
 
 	// perform health check against the API URL that was determined above. (the
@@ -277,38 +448,206 @@ func deployAliasCommand() *cobra.Command {
 	return &deployAlias
 }
 
+func createProxyCommand() *cobra.Command {
+	var loadBalancing string
+	var healthCheckPath string
+	var healthCheckInterval string
+	var headers []string
+
+	createProxy := cobra.Command{
+		Use:     "create-proxy domain upstream [upstream...]",
+		Example: "create-proxy app.example.com localhost:3000 localhost:3001 --load-balancing round_robin",
+		Short:   "Creates a deployment that reverse-proxies to one or more existing servers (given in host:port format)",
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			headerMap := map[string]string{}
+			for _, header := range headers {
+				name, value, found := strings.Cut(header, ":")
+				if !found {
+					exit1("Invalid header \"" + header + "\"; expected the format \"Name: value\"")
+				}
+				headerMap[strings.TrimSpace(name)] = strings.TrimSpace(value)
+			}
+
+			proxyBody := golfsdk.DeployProxyBody{
+				Url:       args[0],
+				Upstreams: args[1:],
+				Headers:   headerMap,
+			}
+			if len(loadBalancing) > 0 {
+				proxyBody.LoadBalancing = &loadBalancing
+			}
+			if len(healthCheckPath) > 0 {
+				proxyBody.HealthCheckPath = &healthCheckPath
+			}
+			if len(healthCheckInterval) > 0 {
+				proxyBody.HealthCheckInterval = &healthCheckInterval
+			}
+
+			client := createClient(args[0])
+
+			createBody, createResp, createRespError := client.
+				DefaultAPI.CreateDeployment(ctx).
+				DeploymentCreateInputBody(createDeploymentInputBody(args[0], &createDeploymentGlobalFlags)).
+				Execute()
+			handleResponse(createBody, createResp, createRespError)
+
+			body, resp, respError := client.
+				DefaultAPI.CreateProxy(ctx).
+				DeployProxyBody(proxyBody).
+				Execute()
+			handleResponse(body, resp, respError)
+		},
+	}
+
+	createProxy.Flags().StringVar(
+		&loadBalancing, "load-balancing", "",
+		"How to distribute requests between upstreams: random (the default), round_robin, least_conn, first, or ip_hash.",
+	)
+	createProxy.Flags().StringVar(
+		&healthCheckPath, "health-check-path", "",
+		"Periodically request this path from each upstream and stop sending traffic to ones that fail.",
+	)
+	createProxy.Flags().StringVar(
+		&healthCheckInterval, "health-check-interval", "",
+		"How often to perform health checks, like \"10s\".",
+	)
+	createProxy.Flags().StringArrayVar(
+		&headers, "header", []string{},
+		"Set a request header on proxied requests, in the format \"Name: value\". Can be given multiple times.",
+	)
+
+	addCreateDeploymentFlags(&createProxy)
+
+	return &createProxy
+}
+
+// tars and gzips the directory and uploads the whole thing. this is the older
+// alternative to DeployDirectory, which only uploads files that changed. if
+// preserveExisting is true, the files are added to the deployment's existing
+// files (after the paths in deletions are deleted) instead of replacing them
+func deployTarball(
+	client *golfsdk.APIClient, url string, files string,
+	preserveExisting bool, deletions []string,
+) {
+	fileTree, err := archives.FilesFromDisk(ctx, nil, map[string]string{
+		files: "",
+	})
+	if err != nil {
+		panic(err.Error())
+	}
+	format := archives.CompressedArchive{
+		Compression: archives.Gz{},
+		Archival:    archives.Tar{},
+	}
+	tempFile, tempFileErr := os.CreateTemp("", "files-to-deploy")
+	if tempFileErr != nil {
+		panic(tempFileErr.Error())
+	}
+	defer os.Remove(tempFile.Name())
+
+	archiveErr := format.Archive(ctx, tempFile, fileTree)
+	if archiveErr != nil {
+		panic(archiveErr.Error())
+	}
+
+	tempFile.Seek(0, 0)
+
+	req := client.DefaultAPI.DeployFiles(ctx).Url(url).Contents(tempFile)
+	if preserveExisting {
+		req = req.PreserveExistingFiles(true).Delete(deletions)
+	}
+	body, resp, respError := req.Execute()
+	handleResponse(body, resp, respError)
+}
+
 func deployContentCommand() *cobra.Command {
 	var files string
+	var preview bool
+	var tarball bool
+	var preserveExisting bool
+	var deletions []string
 
 	deployContent := cobra.Command{
 		Use:     "deploy-content [deployment-name]",
-		Example: "deploy-content thing.net --files ./dist",
+		Example: "deploy-content thing.net --files ./dist\ndeploy-content pr-123.preview.thing.net --files ./dist --preview",
 		Short:   "Deploys content",
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			fileTree, err := archives.FilesFromDisk(ctx, nil, map[string]string{
-				files: "",
-			})
-			if err != nil {
-				panic(err.Error())
+			if info, err := os.Stat(files); err != nil || !info.IsDir() {
+				exit1("--files must be a directory")
 			}
-			format := archives.CompressedArchive{
-				Compression: archives.Gz{},
-				Archival:    archives.Tar{},
+
+			client := createClient(args[0])
+
+			if preview {
+				createBody, createResp, createRespError := client.
+					DefaultAPI.CreatePreview(ctx).
+					CreatePreviewBody(golfsdk.CreatePreviewBody{Url: args[0]}).
+					Execute()
+				handleResponse(createBody, createResp, createRespError)
+			} else {
+				createBody, createResp, createRespError := client.
+					DefaultAPI.CreateDeployment(ctx).
+					DeploymentCreateInputBody(createDeploymentInputBody(args[0], &createDeploymentGlobalFlags)).
+					Execute()
+				handleResponse(createBody, createResp, createRespError)
 			}
-			tempFile, tempFileErr := os.CreateTemp("", "files-to-deploy")
-			if tempFileErr != nil {
-				panic(tempFileErr.Error())
+
+			if len(deletions) > 0 && !preserveExisting {
+				exit1("--delete can only be used with --preserve-existing")
 			}
-			defer os.Remove(tempFile.Name())
 
-			archiveErr := format.Archive(ctx, tempFile, fileTree)
-			if archiveErr != nil {
-				panic(archiveErr.Error())
+			// a manifest always describes the entire deployment, so adding to
+			// the existing files requires uploading an archive
+			if tarball || preserveExisting {
+				deployTarball(client, args[0], files, preserveExisting, deletions)
+				return
 			}
 
-			tempFile.Seek(0, 0)
+			// only uploads the files that the server doesn't have yet
+			body, resp, respError := client.DefaultAPI.DeployDirectory(ctx, args[0], files)
+			handleResponse(body, resp, respError)
+		},
+	}
 
+	deployContent.Flags().StringVar(
+		&files, "files", "",
+		"Supply a path to a directory with the content you wish to deploy.",
+	)
+	deployContent.Flags().BoolVar(
+		&tarball, "tarball", false,
+		"Upload the whole directory as a .tar.gz instead of only uploading files that changed.",
+	)
+	deployContent.Flags().BoolVar(
+		&preserveExisting, "preserve-existing", false,
+		"Add the files to the deployment's existing files instead of replacing them.",
+	)
+	deployContent.Flags().StringArrayVar(
+		&deletions, "delete", []string{},
+		"With --preserve-existing, delete this existing file or directory. Can be given multiple times.",
+	)
+	deployContent.Flags().BoolVar(
+		&preview, "preview", false,
+		"Deploy to a preview deployment under another deployment's preview domain, creating it (or extending its lifetime) first.",
+	)
+
+	addCreateDeploymentFlags(&deployContent)
+
+	return &deployContent
+}
+
+func deployContainerCommand() *cobra.Command {
+	var image string
+	var port int64
+	var imageArchive string
+
+	deployContainer := cobra.Command{
+		Use:     "deploy-container [deployment-name]",
+		Example: "deploy-container thing.net --image nginx:latest --port 80",
+		Short:   "Deploys a docker container",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
 			client := createClient(args[0])
 
 			createBody, createResp, createRespError := client.
@@ -317,23 +656,210 @@ func deployContentCommand() *cobra.Command {
 				Execute()
 			handleResponse(createBody, createResp, createRespError)
 
-			body, resp, respError := client.
-				DefaultAPI.DeployFiles(ctx).
+			request := client.
+				DefaultAPI.DeployContainer(ctx).
 				Url(args[0]).
-				Contents(tempFile).
+				Image(image).
+				Port(port)
+
+			if len(imageArchive) > 0 {
+				archiveFile, err := os.Open(imageArchive)
+				if err != nil {
+					exit1(err.Error())
+				}
+				defer archiveFile.Close()
+				request = request.ImageArchive(archiveFile)
+			}
+
+			body, resp, respError := request.Execute()
+			handleResponse(body, resp, respError)
+		},
+	}
+
+	deployContainer.Flags().StringVar(
+		&image, "image", "",
+		"The docker image to run, like \"nginx:latest\".",
+	)
+	deployContainer.Flags().Int64Var(
+		&port, "port", 0,
+		"The port that the process inside the container listens on.",
+	)
+	deployContainer.Flags().StringVar(
+		&imageArchive, "image-archive", "",
+		"Optionally, a path to a tarball created by `docker save` to upload instead of pulling the image.",
+	)
+	deployContainer.MarkFlagRequired("image")
+	deployContainer.MarkFlagRequired("port")
+
+	addCreateDeploymentFlags(&deployContainer)
+
+	return &deployContainer
+}
+
+func deployProcessCommand() *cobra.Command {
+	var executable string
+	var entrypoint string
+
+	deployProcess := cobra.Command{
+		Use:     "deploy-process [deployment-name]",
+		Example: "deploy-process thing.net --executable ./server",
+		Short:   "Deploys an executable that the server will run and keep running",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			executableFile, err := os.Open(executable)
+			if err != nil {
+				exit1(err.Error())
+			}
+			defer executableFile.Close()
+
+			client := createClient(args[0])
+
+			createBody, createResp, createRespError := client.
+				DefaultAPI.CreateDeployment(ctx).
+				DeploymentCreateInputBody(createDeploymentInputBody(args[0], &createDeploymentGlobalFlags)).
 				Execute()
+			handleResponse(createBody, createResp, createRespError)
+
+			request := client.
+				DefaultAPI.DeployProcess(ctx).
+				Url(args[0]).
+				Executable(executableFile)
+			if len(entrypoint) > 0 {
+				request = request.Entrypoint(entrypoint)
+			}
+
+			body, resp, respError := request.Execute()
 			handleResponse(body, resp, respError)
 		},
 	}
 
-	deployContent.Flags().StringVar(
-		&files, "files", "",
-		"Supply a path to a directory with the content you wish to deploy.",
+	deployProcess.Flags().StringVar(
+		&executable, "executable", "",
+		"Path to the executable to run, or to a .tar.gz that contains it (in which case, --entrypoint is also needed.)",
+	)
+	deployProcess.Flags().StringVar(
+		&entrypoint, "entrypoint", "",
+		"If --executable is a .tar.gz, the path of the executable within it.",
 	)
+	deployProcess.MarkFlagRequired("executable")
 
-	addCreateDeploymentFlags(&deployContent)
+	addCreateDeploymentFlags(&deployProcess)
 
-	return &deployContent
+	return &deployProcess
+}
+
+func processLogsCommand() *cobra.Command {
+	processLogs := cobra.Command{
+		Use:     "logs [deployment-name]",
+		Example: "logs thing.net",
+		Short:   "Shows the recent output of a process deployment",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client := createClient(args[0])
+
+			body, resp, err := client.DefaultAPI.GetProcessLogs(ctx, args[0]).Execute()
+			if err != nil || body == nil {
+				handleResponse(nil, resp, err)
+			}
+			fmt.Print(body.Logs)
+		},
+	}
+
+	return &processLogs
+}
+
+func rollbackCommand() *cobra.Command {
+	rollback := cobra.Command{
+		Use:     "rollback domain [revision]",
+		Example: "rollback thing.net 3f2a9c",
+		Short:   "Points a deployment back at an earlier revision of its content. Defaults to the revision before the current one",
+		Args:    cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			client := createClient(args[0])
+
+			rollbackBody := golfsdk.RollbackBody{Url: args[0]}
+			if len(args) > 1 {
+				rollbackBody.Revision = &args[1]
+			}
+
+			body, resp, respError := client.
+				DefaultAPI.Rollback(ctx).
+				RollbackBody(rollbackBody).
+				Execute()
+			handleResponse(body, resp, respError)
+		},
+	}
+
+	return &rollback
+}
+
+func revisionsCommand() *cobra.Command {
+	revisions := cobra.Command{
+		Use:     "revisions domain",
+		Example: "revisions thing.net",
+		Short:   "Lists the revisions of a deployment's content that can be rolled back to",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client := createClient(args[0])
+
+			body, resp, err := client.DefaultAPI.GetRevisions(ctx, args[0]).Execute()
+			if err != nil || body == nil {
+				handleResponse(nil, resp, err)
+			}
+			for _, revision := range body.Revisions {
+				active := ""
+				if revision.Active {
+					active = " (active)"
+				}
+				fmt.Printf(
+					"%s  %s  %d bytes  uploaded by %s%s\n",
+					revision.Hash, revision.CreatedAt, revision.Size, revision.UploadedBy, active,
+				)
+			}
+		},
+	}
+
+	return &revisions
+}
+
+func collectGarbageCommand() *cobra.Command {
+	var dryRun bool
+
+	collectGarbage := cobra.Command{
+		Use:   "gc",
+		Short: "Deletes deployment content on the server that isn't used anymore",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			client := createClient("")
+
+			body, resp, err := client.DefaultAPI.
+				CollectGarbage(ctx).
+				CollectGarbageInputBody(golfsdk.CollectGarbageInputBody{DryRun: &dryRun}).
+				Execute()
+			if err != nil || body == nil {
+				handleResponse(nil, resp, err)
+			}
+
+			verb := "Deleted"
+			if dryRun {
+				verb = "Would delete"
+			}
+			for _, dir := range body.Deleted {
+				fmt.Printf("%s %s (%d bytes)\n", verb, dir.Path, dir.Size)
+			}
+			for _, dir := range body.Pending {
+				fmt.Printf("Unused since %s, will be deleted later: %s\n", dir.OrphanedSince, dir.Path)
+			}
+			fmt.Printf("%s %d bytes in total\n", verb, body.FreedBytes)
+		},
+	}
+
+	collectGarbage.Flags().BoolVar(
+		&dryRun, "dry-run", false,
+		"Only report what would be deleted.",
+	)
+
+	return &collectGarbage
 }
 
 func registerExternalUserCommand() *cobra.Command {
@@ -366,18 +892,39 @@ func registerExternalUserCommand() *cobra.Command {
 }
 
 func createBearerTokenCommand() *cobra.Command {
+	var urls, urlPrefixes, tags, actions []string
+	var name, expiresIn string
+
 	createToken := cobra.Command{
 		Use:   "create-token",
 		Short: "Create a bearer token that can be used to authenticate API requests",
-		Args:  cobra.NoArgs,
+		Long: "Create a bearer token that can be used to authenticate API requests. By default, the token can do anything; " +
+			"to limit it to some deployments, use --url, --url-prefix, or --tag along with --actions.",
+		Args: cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
+			input := golfsdk.CreateBearerTokenInputBody{FullPermissions: true}
+			if len(urls) > 0 || len(urlPrefixes) > 0 || len(tags) > 0 {
+				if len(actions) == 0 {
+					exit1("--actions is required for a token that's limited to some deployments")
+				}
+				input.FullPermissions = false
+				input.Scopes = []golfsdk.ScopeModel{{
+					Urls: urls, UrlPrefixes: urlPrefixes, Tags: tags, Actions: actions,
+				}}
+			} else if len(actions) > 0 {
+				exit1("--actions needs --url, --url-prefix, or --tag to say which deployments it's for")
+			}
+			if len(name) > 0 {
+				input.Name = &name
+			}
+			if len(expiresIn) > 0 {
+				input.ExpiresIn = &expiresIn
+			}
+
 			client := createClient("")
 			body, resp, err := client.DefaultAPI.
 				PostTokenGenerate(ctx).
-				CreateBearerTokenInputBody(
-					// TODO: granular permissions
-					golfsdk.CreateBearerTokenInputBody{FullPermissions: true},
-				).Execute()
+				CreateBearerTokenInputBody(input).Execute()
 			if err != nil || body == nil || len(body.Token) == 0 {
 				handleResponse(nil, resp, err)
 			}
@@ -386,9 +933,182 @@ func createBearerTokenCommand() *cobra.Command {
 		},
 	}
 
+	createToken.Flags().StringVar(&name, "name", "", "A name for the token, to tell it apart from the others")
+	createToken.Flags().StringVar(
+		&expiresIn, "expires-in", "",
+		"How long the token should work for, like \"720h\". By default, it works until it's revoked",
+	)
+	createToken.Flags().StringSliceVar(&urls, "url", []string{}, "Only allow the token to be used with the deployments at these URLs")
+	createToken.Flags().StringSliceVar(
+		&urlPrefixes, "url-prefix", []string{},
+		"Only allow the token to be used with deployments whose URLs start with one of these, like \"staging.mysite.com\"",
+	)
+	createToken.Flags().StringSliceVar(&tags, "tag", []string{}, "Only allow the token to be used with deployments that have one of these tags")
+	createToken.Flags().StringSliceVar(
+		&actions, "actions", []string{},
+		"What the token can do with those deployments. Options: view, deploy, modify, delete",
+	)
+
 	return &createToken
 }
 
+func listBearerTokensCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokens",
+		Short: "List the bearer tokens that have been created",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			client := createClient("")
+			body, resp, err := client.DefaultAPI.GetTokens(ctx).Execute()
+			if err != nil || body == nil {
+				handleResponse(nil, resp, err)
+			}
+			if len(body.Tokens) == 0 {
+				fmt.Println("No tokens have been created")
+				return
+			}
+			for _, token := range body.Tokens {
+				line := token.Id
+				if token.Name != nil {
+					line += "  " + *token.Name
+				}
+				if token.FullPermissions {
+					line += "  full permissions"
+				} else {
+					line += fmt.Sprintf("  %d scope(s)", len(token.Scopes))
+				}
+				line += "  created " + token.CreatedAt
+				if token.ExpiresAt != nil {
+					line += "  expires " + *token.ExpiresAt
+				}
+				if token.LastUsedAt != nil {
+					line += "  last used " + *token.LastUsedAt
+				}
+				if token.RevokedAt != nil {
+					line += "  (revoked " + *token.RevokedAt + ")"
+				}
+				fmt.Println(line)
+			}
+		},
+	}
+}
+
+func revokeBearerTokenCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-token [id]",
+		Short: "Revoke a bearer token so that it stops working",
+		Long:  "Revoke a bearer token so that it stops working. The ID is the part of the token before the period.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client := createClient("")
+			body, resp, err := client.DefaultAPI.RevokeToken(ctx, args[0]).Execute()
+			handleResponse(body, resp, err)
+		},
+	}
+}
+
+func rotateBearerTokenCommand() *cobra.Command {
+	var expiresIn string
+
+	rotateToken := cobra.Command{
+		Use:   "rotate-token [id]",
+		Short: "Replace a bearer token with a new one that has the same permissions",
+		Long: "Replace a bearer token with a new one that has the same ID, name, and permissions. " +
+			"The old token stops working right away.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			input := golfsdk.RotateBearerTokenInputBody{}
+			if len(expiresIn) > 0 {
+				input.ExpiresIn = &expiresIn
+			}
+
+			client := createClient("")
+			body, resp, err := client.DefaultAPI.
+				RotateToken(ctx, args[0]).
+				RotateBearerTokenInputBody(input).Execute()
+			if err != nil || body == nil || len(body.Token) == 0 {
+				handleResponse(nil, resp, err)
+			}
+			fmt.Println("New token:")
+			fmt.Println(body.Token)
+		},
+	}
+
+	rotateToken.Flags().StringVar(
+		&expiresIn, "expires-in", "",
+		"How long the new token should work for, like \"720h\". By default, it keeps the old token's expiry",
+	)
+
+	return &rotateToken
+}
+
+func auditCommand() *cobra.Command {
+	var actor, action, target, outcome, since, until string
+	var limit int64
+
+	audit := cobra.Command{
+		Use:     "audit",
+		Example: "audit --target thing.net --since 24h",
+		Short:   "Show the log of everything that has been done with the server's admin API",
+		Long: "Show the log of everything that has been done with the server's admin API, newest first. " +
+			"Each entry has who did it, what they did, what it was done to, and whether it worked.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			client := createClient("")
+			request := client.DefaultAPI.GetAuditLog(ctx).Limit(limit)
+			if len(actor) > 0 {
+				request = request.Actor(actor)
+			}
+			if len(action) > 0 {
+				request = request.Action(action)
+			}
+			if len(target) > 0 {
+				request = request.Target(target)
+			}
+			if len(outcome) > 0 {
+				request = request.Outcome(outcome)
+			}
+			if len(since) > 0 {
+				request = request.Since(since)
+			}
+			if len(until) > 0 {
+				request = request.Until(until)
+			}
+			body, resp, err := request.Execute()
+			if err != nil || body == nil {
+				handleResponse(nil, resp, err)
+			}
+			if len(body.Entries) == 0 {
+				fmt.Println("No matching entries")
+				return
+			}
+			for _, entry := range body.Entries {
+				line := fmt.Sprintf(
+					"%s  %s  %s", entry.Time.Format(time.RFC3339), entry.Actor, entry.Action,
+				)
+				if entry.Target != nil {
+					line += "  " + *entry.Target
+				}
+				if entry.ContentHash != nil {
+					line += "  " + *entry.ContentHash
+				}
+				line += fmt.Sprintf("  %s (%d)", entry.Outcome, entry.Status)
+				fmt.Println(line)
+			}
+		},
+	}
+
+	audit.Flags().StringVar(&actor, "actor", "", "Only show entries whose actor contains this, like a token ID")
+	audit.Flags().StringVar(&action, "action", "", "Only show entries for this action, like \"DeployFiles\"")
+	audit.Flags().StringVar(&target, "target", "", "Only show entries for this deployment URL, token, or user")
+	audit.Flags().StringVar(&outcome, "outcome", "", "Only show entries with this outcome: success, denied, or failed")
+	audit.Flags().StringVar(&since, "since", "", "Only show entries from after this time (RFC 3339) or this long ago, like \"24h\"")
+	audit.Flags().StringVar(&until, "until", "", "Only show entries from before this, in the same formats as --since")
+	audit.Flags().Int64Var(&limit, "limit", 100, "The most entries to show. Set to 0 to show all of them")
+
+	return &audit
+}
+
 func main() {
 	var cancel context.CancelFunc
 	ctx, cancel = signal.NotifyContext(context.Background(), os.Interrupt)
@@ -403,9 +1123,13 @@ func main() {
 	rootCmd.AddGroup(&golfGroup)
 
 	golfCmds := [](*cobra.Command){
-		createDeploymentCommand(), deployContentCommand(),
+		createDeploymentCommand(), deployContentCommand(), deployContainerCommand(),
+		deployProcessCommand(), processLogsCommand(),
+		rollbackCommand(), revisionsCommand(), collectGarbageCommand(),
 		registerExternalUserCommand(), createBearerTokenCommand(),
-		deployAdminDash(), deployAliasCommand(),
+		listBearerTokensCommand(), revokeBearerTokenCommand(), rotateBearerTokenCommand(),
+		auditCommand(),
+		deployAdminDash(), deployAliasCommand(), createProxyCommand(),
 	}
 	for _, cmd := range golfCmds {
 		cmd.GroupID = "IG"
@@ -413,10 +1137,12 @@ func main() {
 	}
 
 	rootCmd.PersistentFlags().StringVar(
-		&apiUrl, "api-url", "", "Specify the API URL. Will be smartly guessed if not present.",
+		&apiUrl, "api-url", "",
+		"Specify the API URL. Will be smartly guessed if not present.\n"+
+			"Use unix:///path/to/socket for a server's --admin-api-socket.",
 	)
 	rootCmd.PersistentFlags().StringVar(
-		&auth, "auth", "", "Specify a bearer token or give the value \"github-oidc\".",
+		&auth, "auth", "", "Specify a bearer token, give the value \"github-oidc\", or give an OIDC id token from another CI system as \"oidc:[token]\".",
 	)
 
 	if err := rootCmd.Execute(); err != nil {
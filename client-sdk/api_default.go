@@ -24,58 +24,58 @@ import (
 // DefaultAPIService DefaultAPI service
 type DefaultAPIService service
 
-type ApiCreateAliasRequest struct {
+type ApiCheckManifestRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
-	deployAliasBody *DeployAliasBody
+	manifestBody *ManifestBody
 }
 
-func (r ApiCreateAliasRequest) DeployAliasBody(deployAliasBody DeployAliasBody) ApiCreateAliasRequest {
-	r.deployAliasBody = &deployAliasBody
+func (r ApiCheckManifestRequest) ManifestBody(manifestBody ManifestBody) ApiCheckManifestRequest {
+	r.manifestBody = &manifestBody
 	return r
 }
 
-func (r ApiCreateAliasRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
-	return r.ApiService.CreateAliasExecute(r)
+func (r ApiCheckManifestRequest) Execute() (*CheckManifestOutputBody, *http.Response, error) {
+	return r.ApiService.CheckManifestExecute(r)
 }
 
 /*
-CreateAlias Method for CreateAlias
+CheckManifest Method for CheckManifest
 
-Create an alias deployment.
+Find out which of the files in a manifest need to be uploaded before it can be deployed. Files only count as uploaded for the deployment they were uploaded to.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiCreateAliasRequest
+ @return ApiCheckManifestRequest
 */
-func (a *DefaultAPIService) CreateAlias(ctx context.Context) ApiCreateAliasRequest {
-	return ApiCreateAliasRequest{
+func (a *DefaultAPIService) CheckManifest(ctx context.Context) ApiCheckManifestRequest {
+	return ApiCheckManifestRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
 }
 
 // Execute executes the request
-//  @return SuccessOutputBody
-func (a *DefaultAPIService) CreateAliasExecute(r ApiCreateAliasRequest) (*SuccessOutputBody, *http.Response, error) {
+//  @return CheckManifestOutputBody
+func (a *DefaultAPIService) CheckManifestExecute(r ApiCheckManifestRequest) (*CheckManifestOutputBody, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodPut
+		localVarHTTPMethod   = http.MethodPost
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *SuccessOutputBody
+		localVarReturnValue  *CheckManifestOutputBody
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.CreateAlias")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.CheckManifest")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/deploy/alias"
+	localVarPath := localBasePath + "/deploy/manifest/check"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.deployAliasBody == nil {
-		return localVarReturnValue, nil, reportError("deployAliasBody is required and must be specified")
+	if r.manifestBody == nil {
+		return localVarReturnValue, nil, reportError("manifestBody is required and must be specified")
 	}
 
 	// to determine the Content-Type header
@@ -96,7 +96,7 @@ func (a *DefaultAPIService) CreateAliasExecute(r ApiCreateAliasRequest) (*Succes
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
 	// body params
-	localVarPostBody = r.deployAliasBody
+	localVarPostBody = r.manifestBody
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -142,58 +142,58 @@ func (a *DefaultAPIService) CreateAliasExecute(r ApiCreateAliasRequest) (*Succes
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiCreateDeploymentRequest struct {
+type ApiCollectGarbageRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
-	deploymentCreateInputBody *DeploymentCreateInputBody
+	collectGarbageInputBody *CollectGarbageInputBody
 }
 
-func (r ApiCreateDeploymentRequest) DeploymentCreateInputBody(deploymentCreateInputBody DeploymentCreateInputBody) ApiCreateDeploymentRequest {
-	r.deploymentCreateInputBody = &deploymentCreateInputBody
+func (r ApiCollectGarbageRequest) CollectGarbageInputBody(collectGarbageInputBody CollectGarbageInputBody) ApiCollectGarbageRequest {
+	r.collectGarbageInputBody = &collectGarbageInputBody
 	return r
 }
 
-func (r ApiCreateDeploymentRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
-	return r.ApiService.CreateDeploymentExecute(r)
+func (r ApiCollectGarbageRequest) Execute() (*CollectGarbageOutputBody, *http.Response, error) {
+	return r.ApiService.CollectGarbageExecute(r)
 }
 
 /*
-CreateDeployment Method for CreateDeployment
+CollectGarbage Method for CollectGarbage
 
-Create a new deployment.
+Delete deployment content that isn't used by any deployment or revision anymore.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiCreateDeploymentRequest
+ @return ApiCollectGarbageRequest
 */
-func (a *DefaultAPIService) CreateDeployment(ctx context.Context) ApiCreateDeploymentRequest {
-	return ApiCreateDeploymentRequest{
+func (a *DefaultAPIService) CollectGarbage(ctx context.Context) ApiCollectGarbageRequest {
+	return ApiCollectGarbageRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
 }
 
 // Execute executes the request
-//  @return SuccessOutputBody
-func (a *DefaultAPIService) CreateDeploymentExecute(r ApiCreateDeploymentRequest) (*SuccessOutputBody, *http.Response, error) {
+//  @return CollectGarbageOutputBody
+func (a *DefaultAPIService) CollectGarbageExecute(r ApiCollectGarbageRequest) (*CollectGarbageOutputBody, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodPut
+		localVarHTTPMethod   = http.MethodPost
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *SuccessOutputBody
+		localVarReturnValue  *CollectGarbageOutputBody
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.CreateDeployment")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.CollectGarbage")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/deploy/new"
+	localVarPath := localBasePath + "/gc"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.deploymentCreateInputBody == nil {
-		return localVarReturnValue, nil, reportError("deploymentCreateInputBody is required and must be specified")
+	if r.collectGarbageInputBody == nil {
+		return localVarReturnValue, nil, reportError("collectGarbageInputBody is required and must be specified")
 	}
 
 	// to determine the Content-Type header
@@ -214,7 +214,7 @@ func (a *DefaultAPIService) CreateDeploymentExecute(r ApiCreateDeploymentRequest
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
 	// body params
-	localVarPostBody = r.deploymentCreateInputBody
+	localVarPostBody = r.collectGarbageInputBody
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -260,31 +260,31 @@ func (a *DefaultAPIService) CreateDeploymentExecute(r ApiCreateDeploymentRequest
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiDeployAdminDashRequest struct {
+type ApiCreateAliasRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
-	deployAdminDashBody *DeployAdminDashBody
+	deployAliasBody *DeployAliasBody
 }
 
-func (r ApiDeployAdminDashRequest) DeployAdminDashBody(deployAdminDashBody DeployAdminDashBody) ApiDeployAdminDashRequest {
-	r.deployAdminDashBody = &deployAdminDashBody
+func (r ApiCreateAliasRequest) DeployAliasBody(deployAliasBody DeployAliasBody) ApiCreateAliasRequest {
+	r.deployAliasBody = &deployAliasBody
 	return r
 }
 
-func (r ApiDeployAdminDashRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
-	return r.ApiService.DeployAdminDashExecute(r)
+func (r ApiCreateAliasRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.CreateAliasExecute(r)
 }
 
 /*
-DeployAdminDash Method for DeployAdminDash
+CreateAlias Method for CreateAlias
 
-Deploy the admin dashboard to a specified URL.
+Create an alias deployment.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiDeployAdminDashRequest
+ @return ApiCreateAliasRequest
 */
-func (a *DefaultAPIService) DeployAdminDash(ctx context.Context) ApiDeployAdminDashRequest {
-	return ApiDeployAdminDashRequest{
+func (a *DefaultAPIService) CreateAlias(ctx context.Context) ApiCreateAliasRequest {
+	return ApiCreateAliasRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
@@ -292,7 +292,7 @@ func (a *DefaultAPIService) DeployAdminDash(ctx context.Context) ApiDeployAdminD
 
 // Execute executes the request
 //  @return SuccessOutputBody
-func (a *DefaultAPIService) DeployAdminDashExecute(r ApiDeployAdminDashRequest) (*SuccessOutputBody, *http.Response, error) {
+func (a *DefaultAPIService) CreateAliasExecute(r ApiCreateAliasRequest) (*SuccessOutputBody, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodPut
 		localVarPostBody     interface{}
@@ -300,18 +300,18 @@ func (a *DefaultAPIService) DeployAdminDashExecute(r ApiDeployAdminDashRequest)
 		localVarReturnValue  *SuccessOutputBody
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.DeployAdminDash")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.CreateAlias")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin-dash"
+	localVarPath := localBasePath + "/deploy/alias"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.deployAdminDashBody == nil {
-		return localVarReturnValue, nil, reportError("deployAdminDashBody is required and must be specified")
+	if r.deployAliasBody == nil {
+		return localVarReturnValue, nil, reportError("deployAliasBody is required and must be specified")
 	}
 
 	// to determine the Content-Type header
@@ -332,7 +332,7 @@ func (a *DefaultAPIService) DeployAdminDashExecute(r ApiDeployAdminDashRequest)
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
 	// body params
-	localVarPostBody = r.deployAdminDashBody
+	localVarPostBody = r.deployAliasBody
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -378,53 +378,31 @@ func (a *DefaultAPIService) DeployAdminDashExecute(r ApiDeployAdminDashRequest)
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiDeployFilesRequest struct {
+type ApiCreateDeploymentRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
-	url *string
-	contents *os.File
-	keepLeadingDirectories *bool
-	preserveExistingFiles *bool
-}
-
-// The URL of the deployment that you&#39;re updating.
-func (r ApiDeployFilesRequest) Url(url string) ApiDeployFilesRequest {
-	r.url = &url
-	return r
-}
-
-// A .tar.gz that contains the files to be deployed.
-func (r ApiDeployFilesRequest) Contents(contents *os.File) ApiDeployFilesRequest {
-	r.contents = contents
-	return r
-}
-
-// By default, if you upload a .tar.gz whose contents are all in one folder, the contents of that folder will be used instead of the folder itself. For example, if you upload a folder called &#39;dist&#39; for the deployment &#39;mysite.com&#39;, the URL of your site content will not be at &#39;mysite.com/dist&#39;. Setting this to true turns off that auto-unpacking.
-func (r ApiDeployFilesRequest) KeepLeadingDirectories(keepLeadingDirectories bool) ApiDeployFilesRequest {
-	r.keepLeadingDirectories = &keepLeadingDirectories
-	return r
+	deploymentCreateInputBody *DeploymentCreateInputBody
 }
 
-// Leave the existing files for the current deployment in place instead of completely replacing them.
-func (r ApiDeployFilesRequest) PreserveExistingFiles(preserveExistingFiles bool) ApiDeployFilesRequest {
-	r.preserveExistingFiles = &preserveExistingFiles
+func (r ApiCreateDeploymentRequest) DeploymentCreateInputBody(deploymentCreateInputBody DeploymentCreateInputBody) ApiCreateDeploymentRequest {
+	r.deploymentCreateInputBody = &deploymentCreateInputBody
 	return r
 }
 
-func (r ApiDeployFilesRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
-	return r.ApiService.DeployFilesExecute(r)
+func (r ApiCreateDeploymentRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.CreateDeploymentExecute(r)
 }
 
 /*
-DeployFiles Method for DeployFiles
+CreateDeployment Method for CreateDeployment
 
-Put files in an existing deployment.
+Create a new deployment.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiDeployFilesRequest
+ @return ApiCreateDeploymentRequest
 */
-func (a *DefaultAPIService) DeployFiles(ctx context.Context) ApiDeployFilesRequest {
-	return ApiDeployFilesRequest{
+func (a *DefaultAPIService) CreateDeployment(ctx context.Context) ApiCreateDeploymentRequest {
+	return ApiCreateDeploymentRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
@@ -432,7 +410,7 @@ func (a *DefaultAPIService) DeployFiles(ctx context.Context) ApiDeployFilesReque
 
 // Execute executes the request
 //  @return SuccessOutputBody
-func (a *DefaultAPIService) DeployFilesExecute(r ApiDeployFilesRequest) (*SuccessOutputBody, *http.Response, error) {
+func (a *DefaultAPIService) CreateDeploymentExecute(r ApiCreateDeploymentRequest) (*SuccessOutputBody, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodPut
 		localVarPostBody     interface{}
@@ -440,22 +418,22 @@ func (a *DefaultAPIService) DeployFilesExecute(r ApiDeployFilesRequest) (*Succes
 		localVarReturnValue  *SuccessOutputBody
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.DeployFiles")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.CreateDeployment")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/deploy/files"
+	localVarPath := localBasePath + "/deploy/new"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.url == nil {
-		return localVarReturnValue, nil, reportError("url is required and must be specified")
+	if r.deploymentCreateInputBody == nil {
+		return localVarReturnValue, nil, reportError("deploymentCreateInputBody is required and must be specified")
 	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"multipart/form-data"}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -471,28 +449,8 @@ func (a *DefaultAPIService) DeployFilesExecute(r ApiDeployFilesRequest) (*Succes
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	var contentsLocalVarFormFileName string
-	var contentsLocalVarFileName     string
-	var contentsLocalVarFileBytes    []byte
-
-	contentsLocalVarFormFileName = "contents"
-	contentsLocalVarFile := r.contents
-
-	if contentsLocalVarFile != nil {
-		fbs, _ := io.ReadAll(contentsLocalVarFile)
-
-		contentsLocalVarFileBytes = fbs
-		contentsLocalVarFileName = contentsLocalVarFile.Name()
-		contentsLocalVarFile.Close()
-		formFiles = append(formFiles, formFile{fileBytes: contentsLocalVarFileBytes, fileName: contentsLocalVarFileName, formFileName: contentsLocalVarFormFileName})
-	}
-	if r.keepLeadingDirectories != nil {
-		parameterAddToHeaderOrQuery(localVarFormParams, "keepLeadingDirectories", r.keepLeadingDirectories, "form", "")
-	}
-	if r.preserveExistingFiles != nil {
-		parameterAddToHeaderOrQuery(localVarFormParams, "preserveExistingFiles", r.preserveExistingFiles, "form", "")
-	}
-	parameterAddToHeaderOrQuery(localVarFormParams, "url", r.url, "form", "")
+	// body params
+	localVarPostBody = r.deploymentCreateInputBody
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -538,57 +496,62 @@ func (a *DefaultAPIService) DeployFilesExecute(r ApiDeployFilesRequest) (*Succes
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetDeploymentRequest struct {
+type ApiCreatePreviewRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
-	url string
+	createPreviewBody *CreatePreviewBody
 }
 
-func (r ApiGetDeploymentRequest) Execute() (*GetDeployment200Response, *http.Response, error) {
-	return r.ApiService.GetDeploymentExecute(r)
+func (r ApiCreatePreviewRequest) CreatePreviewBody(createPreviewBody CreatePreviewBody) ApiCreatePreviewRequest {
+	r.createPreviewBody = &createPreviewBody
+	return r
+}
+
+func (r ApiCreatePreviewRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.CreatePreviewExecute(r)
 }
 
 /*
-GetDeployment Method for GetDeployment
+CreatePreview Method for CreatePreview
 
-Retrieve an active deployment.
+Create a preview deployment under another deployment's preview domain, or extend the lifetime of an existing one.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @param url
- @return ApiGetDeploymentRequest
+ @return ApiCreatePreviewRequest
 */
-func (a *DefaultAPIService) GetDeployment(ctx context.Context, url string) ApiGetDeploymentRequest {
-	return ApiGetDeploymentRequest{
+func (a *DefaultAPIService) CreatePreview(ctx context.Context) ApiCreatePreviewRequest {
+	return ApiCreatePreviewRequest{
 		ApiService: a,
 		ctx: ctx,
-		url: url,
 	}
 }
 
 // Execute executes the request
-//  @return GetDeployment200Response
-func (a *DefaultAPIService) GetDeploymentExecute(r ApiGetDeploymentRequest) (*GetDeployment200Response, *http.Response, error) {
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) CreatePreviewExecute(r ApiCreatePreviewRequest) (*SuccessOutputBody, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodGet
+		localVarHTTPMethod   = http.MethodPut
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *GetDeployment200Response
+		localVarReturnValue  *SuccessOutputBody
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetDeployment")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.CreatePreview")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/deployment/{url}"
-	localVarPath = strings.Replace(localVarPath, "{"+"url"+"}", url.PathEscape(parameterValueToString(r.url, "url")), -1)
+	localVarPath := localBasePath + "/deploy/preview"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.createPreviewBody == nil {
+		return localVarReturnValue, nil, reportError("createPreviewBody is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -604,6 +567,8 @@ func (a *DefaultAPIService) GetDeploymentExecute(r ApiGetDeploymentRequest) (*Ge
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.createPreviewBody
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -649,53 +614,62 @@ func (a *DefaultAPIService) GetDeploymentExecute(r ApiGetDeploymentRequest) (*Ge
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetDeploymentsRequest struct {
+type ApiCreateProxyRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
+	deployProxyBody *DeployProxyBody
 }
 
-func (r ApiGetDeploymentsRequest) Execute() (*GetDeployments200Response, *http.Response, error) {
-	return r.ApiService.GetDeploymentsExecute(r)
+func (r ApiCreateProxyRequest) DeployProxyBody(deployProxyBody DeployProxyBody) ApiCreateProxyRequest {
+	r.deployProxyBody = &deployProxyBody
+	return r
+}
+
+func (r ApiCreateProxyRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.CreateProxyExecute(r)
 }
 
 /*
-GetDeployments Method for GetDeployments
+CreateProxy Method for CreateProxy
 
-Retrieve all active deployments.
+Create a deployment that reverse-proxies to one or more existing servers. This needs permission to manage the server, since the servers can be anywhere that the server can reach, including its own internal services.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiGetDeploymentsRequest
+ @return ApiCreateProxyRequest
 */
-func (a *DefaultAPIService) GetDeployments(ctx context.Context) ApiGetDeploymentsRequest {
-	return ApiGetDeploymentsRequest{
+func (a *DefaultAPIService) CreateProxy(ctx context.Context) ApiCreateProxyRequest {
+	return ApiCreateProxyRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
 }
 
 // Execute executes the request
-//  @return GetDeployments200Response
-func (a *DefaultAPIService) GetDeploymentsExecute(r ApiGetDeploymentsRequest) (*GetDeployments200Response, *http.Response, error) {
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) CreateProxyExecute(r ApiCreateProxyRequest) (*SuccessOutputBody, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodGet
+		localVarHTTPMethod   = http.MethodPut
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *GetDeployments200Response
+		localVarReturnValue  *SuccessOutputBody
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetDeployments")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.CreateProxy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/deployments"
+	localVarPath := localBasePath + "/deploy/proxy"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.deployProxyBody == nil {
+		return localVarReturnValue, nil, reportError("deployProxyBody is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -711,6 +685,8 @@ func (a *DefaultAPIService) GetDeploymentsExecute(r ApiGetDeploymentsRequest) (*
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.deployProxyBody
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -756,46 +732,50 @@ func (a *DefaultAPIService) GetDeploymentsExecute(r ApiGetDeploymentsRequest) (*
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiHealthCheckRequest struct {
+type ApiDeleteDeploymentRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
+	url string
 }
 
-func (r ApiHealthCheckRequest) Execute() (*HealthCheckOutputBody, *http.Response, error) {
-	return r.ApiService.HealthCheckExecute(r)
+func (r ApiDeleteDeploymentRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.DeleteDeploymentExecute(r)
 }
 
 /*
-HealthCheck Method for HealthCheck
+DeleteDeployment Method for DeleteDeployment
 
-Find out if the server is up or not.
+Delete a deployment.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiHealthCheckRequest
+ @param url
+ @return ApiDeleteDeploymentRequest
 */
-func (a *DefaultAPIService) HealthCheck(ctx context.Context) ApiHealthCheckRequest {
-	return ApiHealthCheckRequest{
+func (a *DefaultAPIService) DeleteDeployment(ctx context.Context, url string) ApiDeleteDeploymentRequest {
+	return ApiDeleteDeploymentRequest{
 		ApiService: a,
 		ctx: ctx,
+		url: url,
 	}
 }
 
 // Execute executes the request
-//  @return HealthCheckOutputBody
-func (a *DefaultAPIService) HealthCheckExecute(r ApiHealthCheckRequest) (*HealthCheckOutputBody, *http.Response, error) {
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) DeleteDeploymentExecute(r ApiDeleteDeploymentRequest) (*SuccessOutputBody, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodGet
+		localVarHTTPMethod   = http.MethodDelete
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *HealthCheckOutputBody
+		localVarReturnValue  *SuccessOutputBody
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.HealthCheck")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.DeleteDeployment")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/alive"
+	localVarPath := localBasePath + "/deployment/{url}"
+	localVarPath = strings.Replace(localVarPath, "{"+"url"+"}", url.PathEscape(parameterValueToString(r.url, "url")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -863,56 +843,58 @@ func (a *DefaultAPIService) HealthCheckExecute(r ApiHealthCheckRequest) (*Health
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiPostTokenGenerateRequest struct {
+type ApiDeployAdminDashRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
-	createBearerTokenInputBody *CreateBearerTokenInputBody
+	deployAdminDashBody *DeployAdminDashBody
 }
 
-func (r ApiPostTokenGenerateRequest) CreateBearerTokenInputBody(createBearerTokenInputBody CreateBearerTokenInputBody) ApiPostTokenGenerateRequest {
-	r.createBearerTokenInputBody = &createBearerTokenInputBody
+func (r ApiDeployAdminDashRequest) DeployAdminDashBody(deployAdminDashBody DeployAdminDashBody) ApiDeployAdminDashRequest {
+	r.deployAdminDashBody = &deployAdminDashBody
 	return r
 }
 
-func (r ApiPostTokenGenerateRequest) Execute() (*CreateBearerTokenOutputBody, *http.Response, error) {
-	return r.ApiService.PostTokenGenerateExecute(r)
+func (r ApiDeployAdminDashRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.DeployAdminDashExecute(r)
 }
 
 /*
-PostTokenGenerate Post token generate
+DeployAdminDash Method for DeployAdminDash
+
+Deploy the admin dashboard to a specified URL.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiPostTokenGenerateRequest
+ @return ApiDeployAdminDashRequest
 */
-func (a *DefaultAPIService) PostTokenGenerate(ctx context.Context) ApiPostTokenGenerateRequest {
-	return ApiPostTokenGenerateRequest{
+func (a *DefaultAPIService) DeployAdminDash(ctx context.Context) ApiDeployAdminDashRequest {
+	return ApiDeployAdminDashRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
 }
 
 // Execute executes the request
-//  @return CreateBearerTokenOutputBody
-func (a *DefaultAPIService) PostTokenGenerateExecute(r ApiPostTokenGenerateRequest) (*CreateBearerTokenOutputBody, *http.Response, error) {
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) DeployAdminDashExecute(r ApiDeployAdminDashRequest) (*SuccessOutputBody, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodPost
+		localVarHTTPMethod   = http.MethodPut
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *CreateBearerTokenOutputBody
+		localVarReturnValue  *SuccessOutputBody
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.PostTokenGenerate")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.DeployAdminDash")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/token/generate"
+	localVarPath := localBasePath + "/admin-dash"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.createBearerTokenInputBody == nil {
-		return localVarReturnValue, nil, reportError("createBearerTokenInputBody is required and must be specified")
+	if r.deployAdminDashBody == nil {
+		return localVarReturnValue, nil, reportError("deployAdminDashBody is required and must be specified")
 	}
 
 	// to determine the Content-Type header
@@ -933,7 +915,7 @@ func (a *DefaultAPIService) PostTokenGenerateExecute(r ApiPostTokenGenerateReque
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
 	// body params
-	localVarPostBody = r.createBearerTokenInputBody
+	localVarPostBody = r.deployAdminDashBody
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -979,29 +961,53 @@ func (a *DefaultAPIService) PostTokenGenerateExecute(r ApiPostTokenGenerateReque
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiPutUserRegisterRequest struct {
+type ApiDeployContainerRequest struct {
 	ctx context.Context
 	ApiService *DefaultAPIService
-	addExternalUserInputBody *AddExternalUserInputBody
+	image *string
+	port *int64
+	url *string
+	imageArchive *os.File
 }
 
-func (r ApiPutUserRegisterRequest) AddExternalUserInputBody(addExternalUserInputBody AddExternalUserInputBody) ApiPutUserRegisterRequest {
-	r.addExternalUserInputBody = &addExternalUserInputBody
+// The Docker image to run, like &quot;nginx:latest&quot;. If no image archive is uploaded, this will be pulled from its registry.
+func (r ApiDeployContainerRequest) Image(image string) ApiDeployContainerRequest {
+	r.image = &image
 	return r
 }
 
-func (r ApiPutUserRegisterRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
-	return r.ApiService.PutUserRegisterExecute(r)
+// The port that the app inside the container listens on.
+func (r ApiDeployContainerRequest) Port(port int64) ApiDeployContainerRequest {
+	r.port = &port
+	return r
+}
+
+// The URL of the deployment that you&#39;re updating.
+func (r ApiDeployContainerRequest) Url(url string) ApiDeployContainerRequest {
+	r.url = &url
+	return r
+}
+
+// Optional image archive (as created by &quot;docker save&quot;) to load the image from instead of pulling it.
+func (r ApiDeployContainerRequest) ImageArchive(imageArchive *os.File) ApiDeployContainerRequest {
+	r.imageArchive = imageArchive
+	return r
+}
+
+func (r ApiDeployContainerRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.DeployContainerExecute(r)
 }
 
 /*
-PutUserRegister Put user register
+DeployContainer Method for DeployContainer
+
+Run a Docker container for an existing deployment.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiPutUserRegisterRequest
+ @return ApiDeployContainerRequest
 */
-func (a *DefaultAPIService) PutUserRegister(ctx context.Context) ApiPutUserRegisterRequest {
-	return ApiPutUserRegisterRequest{
+func (a *DefaultAPIService) DeployContainer(ctx context.Context) ApiDeployContainerRequest {
+	return ApiDeployContainerRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
@@ -1009,7 +1015,7 @@ func (a *DefaultAPIService) PutUserRegister(ctx context.Context) ApiPutUserRegis
 
 // Execute executes the request
 //  @return SuccessOutputBody
-func (a *DefaultAPIService) PutUserRegisterExecute(r ApiPutUserRegisterRequest) (*SuccessOutputBody, *http.Response, error) {
+func (a *DefaultAPIService) DeployContainerExecute(r ApiDeployContainerRequest) (*SuccessOutputBody, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodPut
 		localVarPostBody     interface{}
@@ -1017,22 +1023,28 @@ func (a *DefaultAPIService) PutUserRegisterExecute(r ApiPutUserRegisterRequest)
 		localVarReturnValue  *SuccessOutputBody
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.PutUserRegister")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.DeployContainer")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/user/register"
+	localVarPath := localBasePath + "/deploy/container"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.addExternalUserInputBody == nil {
-		return localVarReturnValue, nil, reportError("addExternalUserInputBody is required and must be specified")
+	if r.image == nil {
+		return localVarReturnValue, nil, reportError("image is required and must be specified")
+	}
+	if r.port == nil {
+		return localVarReturnValue, nil, reportError("port is required and must be specified")
+	}
+	if r.url == nil {
+		return localVarReturnValue, nil, reportError("url is required and must be specified")
 	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/json"}
+	localVarHTTPContentTypes := []string{"multipart/form-data"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1048,8 +1060,2686 @@ func (a *DefaultAPIService) PutUserRegisterExecute(r ApiPutUserRegisterRequest)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	// body params
-	localVarPostBody = r.addExternalUserInputBody
+	parameterAddToHeaderOrQuery(localVarFormParams, "image", r.image, "form", "")
+	var imageArchiveLocalVarFormFileName string
+	var imageArchiveLocalVarFileName     string
+	var imageArchiveLocalVarFileBytes    []byte
+
+	imageArchiveLocalVarFormFileName = "imageArchive"
+	imageArchiveLocalVarFile := r.imageArchive
+
+	if imageArchiveLocalVarFile != nil {
+		fbs, _ := io.ReadAll(imageArchiveLocalVarFile)
+
+		imageArchiveLocalVarFileBytes = fbs
+		imageArchiveLocalVarFileName = imageArchiveLocalVarFile.Name()
+		imageArchiveLocalVarFile.Close()
+		formFiles = append(formFiles, formFile{fileBytes: imageArchiveLocalVarFileBytes, fileName: imageArchiveLocalVarFileName, formFileName: imageArchiveLocalVarFormFileName})
+	}
+	parameterAddToHeaderOrQuery(localVarFormParams, "port", r.port, "form", "")
+	parameterAddToHeaderOrQuery(localVarFormParams, "url", r.url, "form", "")
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiDeployFilesRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	url *string
+	contents *os.File
+	delete *[]string
+	keepLeadingDirectories *bool
+	preserveExistingFiles *bool
+}
+
+// The URL of the deployment that you&#39;re updating.
+func (r ApiDeployFilesRequest) Url(url string) ApiDeployFilesRequest {
+	r.url = &url
+	return r
+}
+
+// An archive that contains the files to be deployed. The format is detected automatically; .tar.gz, .zip, .tar, .tar.zst, and .tar.xz all work. This has to come after the other fields in the form, since it&#39;s extracted while it&#39;s being uploaded.
+func (r ApiDeployFilesRequest) Contents(contents *os.File) ApiDeployFilesRequest {
+	r.contents = contents
+	return r
+}
+
+// Paths of existing files or directories to delete. Can only be used if preserveExistingFiles is true.
+func (r ApiDeployFilesRequest) Delete(delete []string) ApiDeployFilesRequest {
+	r.delete = &delete
+	return r
+}
+
+// By default, if you upload an archive whose contents are all in one folder, the contents of that folder will be used instead of the folder itself. For example, if you upload a folder called &#39;dist&#39; for the deployment &#39;mysite.com&#39;, the URL of your site content will not be at &#39;mysite.com/dist&#39;. Setting this to true turns off that auto-unpacking.
+func (r ApiDeployFilesRequest) KeepLeadingDirectories(keepLeadingDirectories bool) ApiDeployFilesRequest {
+	r.keepLeadingDirectories = &keepLeadingDirectories
+	return r
+}
+
+// Leave the existing files for the current deployment in place instead of completely replacing them.
+func (r ApiDeployFilesRequest) PreserveExistingFiles(preserveExistingFiles bool) ApiDeployFilesRequest {
+	r.preserveExistingFiles = &preserveExistingFiles
+	return r
+}
+
+func (r ApiDeployFilesRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.DeployFilesExecute(r)
+}
+
+/*
+DeployFiles Method for DeployFiles
+
+Put files in an existing deployment.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiDeployFilesRequest
+*/
+func (a *DefaultAPIService) DeployFiles(ctx context.Context) ApiDeployFilesRequest {
+	return ApiDeployFilesRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) DeployFilesExecute(r ApiDeployFilesRequest) (*SuccessOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuccessOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.DeployFiles")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/deploy/files"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.url == nil {
+		return localVarReturnValue, nil, reportError("url is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"multipart/form-data"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	var contentsLocalVarFormFileName string
+	var contentsLocalVarFileName     string
+	var contentsLocalVarFileBytes    []byte
+
+	contentsLocalVarFormFileName = "contents"
+	contentsLocalVarFile := r.contents
+
+	if contentsLocalVarFile != nil {
+		fbs, _ := io.ReadAll(contentsLocalVarFile)
+
+		contentsLocalVarFileBytes = fbs
+		contentsLocalVarFileName = contentsLocalVarFile.Name()
+		contentsLocalVarFile.Close()
+		formFiles = append(formFiles, formFile{fileBytes: contentsLocalVarFileBytes, fileName: contentsLocalVarFileName, formFileName: contentsLocalVarFormFileName})
+	}
+	if r.delete != nil {
+		parameterAddToHeaderOrQuery(localVarFormParams, "delete", r.delete, "form", "")
+	}
+	if r.keepLeadingDirectories != nil {
+		parameterAddToHeaderOrQuery(localVarFormParams, "keepLeadingDirectories", r.keepLeadingDirectories, "form", "")
+	}
+	if r.preserveExistingFiles != nil {
+		parameterAddToHeaderOrQuery(localVarFormParams, "preserveExistingFiles", r.preserveExistingFiles, "form", "")
+	}
+	parameterAddToHeaderOrQuery(localVarFormParams, "url", r.url, "form", "")
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiDeployManifestRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	manifestBody *ManifestBody
+}
+
+func (r ApiDeployManifestRequest) ManifestBody(manifestBody ManifestBody) ApiDeployManifestRequest {
+	r.manifestBody = &manifestBody
+	return r
+}
+
+func (r ApiDeployManifestRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.DeployManifestExecute(r)
+}
+
+/*
+DeployManifest Method for DeployManifest
+
+Put files in an existing deployment, using files that were already uploaded.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiDeployManifestRequest
+*/
+func (a *DefaultAPIService) DeployManifest(ctx context.Context) ApiDeployManifestRequest {
+	return ApiDeployManifestRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) DeployManifestExecute(r ApiDeployManifestRequest) (*SuccessOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuccessOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.DeployManifest")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/deploy/manifest"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.manifestBody == nil {
+		return localVarReturnValue, nil, reportError("manifestBody is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.manifestBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiDeployProcessRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	executable *os.File
+	url *string
+	entrypoint *string
+}
+
+// Either a single executable or, if entrypoint is set, an archive (like a .tar.gz or .zip) that contains the executable. It will be started with the port that it should listen on in the PORT environment variable.
+func (r ApiDeployProcessRequest) Executable(executable *os.File) ApiDeployProcessRequest {
+	r.executable = executable
+	return r
+}
+
+// The URL of the deployment that you&#39;re updating.
+func (r ApiDeployProcessRequest) Url(url string) ApiDeployProcessRequest {
+	r.url = &url
+	return r
+}
+
+// If the uploaded file is an archive, this is the path of the executable within it.
+func (r ApiDeployProcessRequest) Entrypoint(entrypoint string) ApiDeployProcessRequest {
+	r.entrypoint = &entrypoint
+	return r
+}
+
+func (r ApiDeployProcessRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.DeployProcessExecute(r)
+}
+
+/*
+DeployProcess Method for DeployProcess
+
+Run an executable as a supervised process for an existing deployment. This needs permission to manage the server, since the process runs as the server's user.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiDeployProcessRequest
+*/
+func (a *DefaultAPIService) DeployProcess(ctx context.Context) ApiDeployProcessRequest {
+	return ApiDeployProcessRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) DeployProcessExecute(r ApiDeployProcessRequest) (*SuccessOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuccessOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.DeployProcess")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/deploy/process"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.url == nil {
+		return localVarReturnValue, nil, reportError("url is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"multipart/form-data"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.entrypoint != nil {
+		parameterAddToHeaderOrQuery(localVarFormParams, "entrypoint", r.entrypoint, "form", "")
+	}
+	var executableLocalVarFormFileName string
+	var executableLocalVarFileName     string
+	var executableLocalVarFileBytes    []byte
+
+	executableLocalVarFormFileName = "executable"
+	executableLocalVarFile := r.executable
+
+	if executableLocalVarFile != nil {
+		fbs, _ := io.ReadAll(executableLocalVarFile)
+
+		executableLocalVarFileBytes = fbs
+		executableLocalVarFileName = executableLocalVarFile.Name()
+		executableLocalVarFile.Close()
+		formFiles = append(formFiles, formFile{fileBytes: executableLocalVarFileBytes, fileName: executableLocalVarFileName, formFileName: executableLocalVarFormFileName})
+	}
+	parameterAddToHeaderOrQuery(localVarFormParams, "url", r.url, "form", "")
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiFinishGithubLoginRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	finishGithubLoginInputBody *FinishGithubLoginInputBody
+}
+
+func (r ApiFinishGithubLoginRequest) FinishGithubLoginInputBody(finishGithubLoginInputBody FinishGithubLoginInputBody) ApiFinishGithubLoginRequest {
+	r.finishGithubLoginInputBody = &finishGithubLoginInputBody
+	return r
+}
+
+func (r ApiFinishGithubLoginRequest) Execute() (*FinishGithubLoginOutputBody, *http.Response, error) {
+	return r.ApiService.FinishGithubLoginExecute(r)
+}
+
+/*
+FinishGithubLogin Method for FinishGithubLogin
+
+Finish logging in with Github's device flow. If the user hasn't entered the user code yet, the response says that the login is pending.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiFinishGithubLoginRequest
+*/
+func (a *DefaultAPIService) FinishGithubLogin(ctx context.Context) ApiFinishGithubLoginRequest {
+	return ApiFinishGithubLoginRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return FinishGithubLoginOutputBody
+func (a *DefaultAPIService) FinishGithubLoginExecute(r ApiFinishGithubLoginRequest) (*FinishGithubLoginOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *FinishGithubLoginOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.FinishGithubLogin")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/session/github/finish"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.finishGithubLoginInputBody == nil {
+		return localVarReturnValue, nil, reportError("finishGithubLoginInputBody is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.finishGithubLoginInputBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetAuditLogRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	actor *string
+	action *string
+	target *string
+	outcome *string
+	since *string
+	until *string
+	limit *int64
+}
+
+// Only include entries whose actor contains this, like a token ID or a Github username.
+func (r ApiGetAuditLogRequest) Actor(actor string) ApiGetAuditLogRequest {
+	r.actor = &actor
+	return r
+}
+
+// Only include entries for this action, like &quot;DeployFiles&quot;.
+func (r ApiGetAuditLogRequest) Action(action string) ApiGetAuditLogRequest {
+	r.action = &action
+	return r
+}
+
+// Only include entries for this deployment URL, token ID, or user.
+func (r ApiGetAuditLogRequest) Target(target string) ApiGetAuditLogRequest {
+	r.target = &target
+	return r
+}
+
+// Only include entries with this outcome.
+func (r ApiGetAuditLogRequest) Outcome(outcome string) ApiGetAuditLogRequest {
+	r.outcome = &outcome
+	return r
+}
+
+// Only include entries from after this. Can be a time, like &quot;2025-01-02T15:04:05Z&quot;, or how long ago, like &quot;24h&quot;.
+func (r ApiGetAuditLogRequest) Since(since string) ApiGetAuditLogRequest {
+	r.since = &since
+	return r
+}
+
+// Only include entries from before this, in the same formats as since.
+func (r ApiGetAuditLogRequest) Until(until string) ApiGetAuditLogRequest {
+	r.until = &until
+	return r
+}
+
+// The most entries to return, starting from the newest. 0 means no limit.
+func (r ApiGetAuditLogRequest) Limit(limit int64) ApiGetAuditLogRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiGetAuditLogRequest) Execute() (*GetAuditLogOutputBody, *http.Response, error) {
+	return r.ApiService.GetAuditLogExecute(r)
+}
+
+/*
+GetAuditLog Method for GetAuditLog
+
+Get entries from the log of everything that has been done with the admin API, newest first.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiGetAuditLogRequest
+*/
+func (a *DefaultAPIService) GetAuditLog(ctx context.Context) ApiGetAuditLogRequest {
+	return ApiGetAuditLogRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return GetAuditLogOutputBody
+func (a *DefaultAPIService) GetAuditLogExecute(r ApiGetAuditLogRequest) (*GetAuditLogOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *GetAuditLogOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetAuditLog")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/audit"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.actor != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "actor", r.actor, "form", "")
+	}
+	if r.action != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "action", r.action, "form", "")
+	}
+	if r.target != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "target", r.target, "form", "")
+	}
+	if r.outcome != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "outcome", r.outcome, "form", "")
+	}
+	if r.since != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "since", r.since, "form", "")
+	}
+	if r.until != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "until", r.until, "form", "")
+	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "form", "")
+	} else {
+		var defaultValue int64 = 100
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", defaultValue, "form", "")
+		r.limit = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetDeploymentRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	url string
+}
+
+func (r ApiGetDeploymentRequest) Execute() (*GetDeployment200Response, *http.Response, error) {
+	return r.ApiService.GetDeploymentExecute(r)
+}
+
+/*
+GetDeployment Method for GetDeployment
+
+Retrieve an active deployment.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param url
+ @return ApiGetDeploymentRequest
+*/
+func (a *DefaultAPIService) GetDeployment(ctx context.Context, url string) ApiGetDeploymentRequest {
+	return ApiGetDeploymentRequest{
+		ApiService: a,
+		ctx: ctx,
+		url: url,
+	}
+}
+
+// Execute executes the request
+//  @return GetDeployment200Response
+func (a *DefaultAPIService) GetDeploymentExecute(r ApiGetDeploymentRequest) (*GetDeployment200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *GetDeployment200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetDeployment")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/deployment/{url}"
+	localVarPath = strings.Replace(localVarPath, "{"+"url"+"}", url.PathEscape(parameterValueToString(r.url, "url")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetDeploymentsRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+}
+
+func (r ApiGetDeploymentsRequest) Execute() (*GetDeployments200Response, *http.Response, error) {
+	return r.ApiService.GetDeploymentsExecute(r)
+}
+
+/*
+GetDeployments Method for GetDeployments
+
+Retrieve all active deployments.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiGetDeploymentsRequest
+*/
+func (a *DefaultAPIService) GetDeployments(ctx context.Context) ApiGetDeploymentsRequest {
+	return ApiGetDeploymentsRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return GetDeployments200Response
+func (a *DefaultAPIService) GetDeploymentsExecute(r ApiGetDeploymentsRequest) (*GetDeployments200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *GetDeployments200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetDeployments")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/deployments"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetMetricsRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+}
+
+func (r ApiGetMetricsRequest) Execute() (*GetMetrics200Response, *http.Response, error) {
+	return r.ApiService.GetMetricsExecute(r)
+}
+
+/*
+GetMetrics Method for GetMetrics
+
+Get the server's metrics in the Prometheus text format.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiGetMetricsRequest
+*/
+func (a *DefaultAPIService) GetMetrics(ctx context.Context) ApiGetMetricsRequest {
+	return ApiGetMetricsRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return GetMetrics200Response
+func (a *DefaultAPIService) GetMetricsExecute(r ApiGetMetricsRequest) (*GetMetrics200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *GetMetrics200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetMetrics")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/metrics"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"text/plain", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetProcessLogsRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	url string
+}
+
+func (r ApiGetProcessLogsRequest) Execute() (*GetProcessLogsOutputBody, *http.Response, error) {
+	return r.ApiService.GetProcessLogsExecute(r)
+}
+
+/*
+GetProcessLogs Method for GetProcessLogs
+
+Retrieve the recent output of a process deployment.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param url
+ @return ApiGetProcessLogsRequest
+*/
+func (a *DefaultAPIService) GetProcessLogs(ctx context.Context, url string) ApiGetProcessLogsRequest {
+	return ApiGetProcessLogsRequest{
+		ApiService: a,
+		ctx: ctx,
+		url: url,
+	}
+}
+
+// Execute executes the request
+//  @return GetProcessLogsOutputBody
+func (a *DefaultAPIService) GetProcessLogsExecute(r ApiGetProcessLogsRequest) (*GetProcessLogsOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *GetProcessLogsOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetProcessLogs")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/deployment/{url}/logs"
+	localVarPath = strings.Replace(localVarPath, "{"+"url"+"}", url.PathEscape(parameterValueToString(r.url, "url")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetRevisionsRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	url string
+}
+
+func (r ApiGetRevisionsRequest) Execute() (*GetRevisionsOutputBody, *http.Response, error) {
+	return r.ApiService.GetRevisionsExecute(r)
+}
+
+/*
+GetRevisions Method for GetRevisions
+
+Retrieve the content revisions of a deployment.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param url
+ @return ApiGetRevisionsRequest
+*/
+func (a *DefaultAPIService) GetRevisions(ctx context.Context, url string) ApiGetRevisionsRequest {
+	return ApiGetRevisionsRequest{
+		ApiService: a,
+		ctx: ctx,
+		url: url,
+	}
+}
+
+// Execute executes the request
+//  @return GetRevisionsOutputBody
+func (a *DefaultAPIService) GetRevisionsExecute(r ApiGetRevisionsRequest) (*GetRevisionsOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *GetRevisionsOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetRevisions")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/deployment/{url}/revisions"
+	localVarPath = strings.Replace(localVarPath, "{"+"url"+"}", url.PathEscape(parameterValueToString(r.url, "url")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetSessionRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+}
+
+func (r ApiGetSessionRequest) Execute() (*SessionModel, *http.Response, error) {
+	return r.ApiService.GetSessionExecute(r)
+}
+
+/*
+GetSession Method for GetSession
+
+Find out who the request is authenticated as. The CSRF token is included if the request used a session cookie.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiGetSessionRequest
+*/
+func (a *DefaultAPIService) GetSession(ctx context.Context) ApiGetSessionRequest {
+	return ApiGetSessionRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return SessionModel
+func (a *DefaultAPIService) GetSessionExecute(r ApiGetSessionRequest) (*SessionModel, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SessionModel
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetSession")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/session"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetTokensRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+}
+
+func (r ApiGetTokensRequest) Execute() (*GetBearerTokensOutputBody, *http.Response, error) {
+	return r.ApiService.GetTokensExecute(r)
+}
+
+/*
+GetTokens Method for GetTokens
+
+List the bearer tokens that have been created, without their secrets.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiGetTokensRequest
+*/
+func (a *DefaultAPIService) GetTokens(ctx context.Context) ApiGetTokensRequest {
+	return ApiGetTokensRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return GetBearerTokensOutputBody
+func (a *DefaultAPIService) GetTokensExecute(r ApiGetTokensRequest) (*GetBearerTokensOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *GetBearerTokensOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.GetTokens")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/tokens"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiHealthCheckRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+}
+
+func (r ApiHealthCheckRequest) Execute() (*HealthCheckOutputBody, *http.Response, error) {
+	return r.ApiService.HealthCheckExecute(r)
+}
+
+/*
+HealthCheck Method for HealthCheck
+
+Find out if the server is up or not.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiHealthCheckRequest
+*/
+func (a *DefaultAPIService) HealthCheck(ctx context.Context) ApiHealthCheckRequest {
+	return ApiHealthCheckRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return HealthCheckOutputBody
+func (a *DefaultAPIService) HealthCheckExecute(r ApiHealthCheckRequest) (*HealthCheckOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *HealthCheckOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.HealthCheck")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/alive"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiLoginRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	loginInputBody *LoginInputBody
+}
+
+func (r ApiLoginRequest) LoginInputBody(loginInputBody LoginInputBody) ApiLoginRequest {
+	r.loginInputBody = &loginInputBody
+	return r
+}
+
+func (r ApiLoginRequest) Execute() (*SessionModel, *http.Response, error) {
+	return r.ApiService.LoginExecute(r)
+}
+
+/*
+Login Method for Login
+
+Start an admin dashboard session with a bearer token. The session is kept in an HTTP-only cookie.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiLoginRequest
+*/
+func (a *DefaultAPIService) Login(ctx context.Context) ApiLoginRequest {
+	return ApiLoginRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return SessionModel
+func (a *DefaultAPIService) LoginExecute(r ApiLoginRequest) (*SessionModel, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SessionModel
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.Login")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/session/login"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.loginInputBody == nil {
+		return localVarReturnValue, nil, reportError("loginInputBody is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.loginInputBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiLogoutRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+}
+
+func (r ApiLogoutRequest) Execute() (*LogoutOutputBody, *http.Response, error) {
+	return r.ApiService.LogoutExecute(r)
+}
+
+/*
+Logout Method for Logout
+
+End the admin dashboard session that the request's cookie is for.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiLogoutRequest
+*/
+func (a *DefaultAPIService) Logout(ctx context.Context) ApiLogoutRequest {
+	return ApiLogoutRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return LogoutOutputBody
+func (a *DefaultAPIService) LogoutExecute(r ApiLogoutRequest) (*LogoutOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *LogoutOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.Logout")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/session/logout"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiPostTokenGenerateRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	createBearerTokenInputBody *CreateBearerTokenInputBody
+}
+
+func (r ApiPostTokenGenerateRequest) CreateBearerTokenInputBody(createBearerTokenInputBody CreateBearerTokenInputBody) ApiPostTokenGenerateRequest {
+	r.createBearerTokenInputBody = &createBearerTokenInputBody
+	return r
+}
+
+func (r ApiPostTokenGenerateRequest) Execute() (*CreateBearerTokenOutputBody, *http.Response, error) {
+	return r.ApiService.PostTokenGenerateExecute(r)
+}
+
+/*
+PostTokenGenerate Post token generate
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiPostTokenGenerateRequest
+*/
+func (a *DefaultAPIService) PostTokenGenerate(ctx context.Context) ApiPostTokenGenerateRequest {
+	return ApiPostTokenGenerateRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return CreateBearerTokenOutputBody
+func (a *DefaultAPIService) PostTokenGenerateExecute(r ApiPostTokenGenerateRequest) (*CreateBearerTokenOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *CreateBearerTokenOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.PostTokenGenerate")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/token/generate"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.createBearerTokenInputBody == nil {
+		return localVarReturnValue, nil, reportError("createBearerTokenInputBody is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.createBearerTokenInputBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiPutUserRegisterRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	addExternalUserInputBody *AddExternalUserInputBody
+}
+
+func (r ApiPutUserRegisterRequest) AddExternalUserInputBody(addExternalUserInputBody AddExternalUserInputBody) ApiPutUserRegisterRequest {
+	r.addExternalUserInputBody = &addExternalUserInputBody
+	return r
+}
+
+func (r ApiPutUserRegisterRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.PutUserRegisterExecute(r)
+}
+
+/*
+PutUserRegister Put user register
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiPutUserRegisterRequest
+*/
+func (a *DefaultAPIService) PutUserRegister(ctx context.Context) ApiPutUserRegisterRequest {
+	return ApiPutUserRegisterRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) PutUserRegisterExecute(r ApiPutUserRegisterRequest) (*SuccessOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuccessOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.PutUserRegister")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/user/register"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.addExternalUserInputBody == nil {
+		return localVarReturnValue, nil, reportError("addExternalUserInputBody is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.addExternalUserInputBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiRevokeTokenRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	id string
+}
+
+func (r ApiRevokeTokenRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.RevokeTokenExecute(r)
+}
+
+/*
+RevokeToken Method for RevokeToken
+
+Revoke a bearer token, so that it stops working.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id
+ @return ApiRevokeTokenRequest
+*/
+func (a *DefaultAPIService) RevokeToken(ctx context.Context, id string) ApiRevokeTokenRequest {
+	return ApiRevokeTokenRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) RevokeTokenExecute(r ApiRevokeTokenRequest) (*SuccessOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodDelete
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuccessOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.RevokeToken")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/token/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiRollbackRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	rollbackBody *RollbackBody
+}
+
+func (r ApiRollbackRequest) RollbackBody(rollbackBody RollbackBody) ApiRollbackRequest {
+	r.rollbackBody = &rollbackBody
+	return r
+}
+
+func (r ApiRollbackRequest) Execute() (*SuccessOutputBody, *http.Response, error) {
+	return r.ApiService.RollbackExecute(r)
+}
+
+/*
+Rollback Method for Rollback
+
+Point a deployment back at one of its earlier content revisions.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiRollbackRequest
+*/
+func (a *DefaultAPIService) Rollback(ctx context.Context) ApiRollbackRequest {
+	return ApiRollbackRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return SuccessOutputBody
+func (a *DefaultAPIService) RollbackExecute(r ApiRollbackRequest) (*SuccessOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SuccessOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.Rollback")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/deploy/rollback"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.rollbackBody == nil {
+		return localVarReturnValue, nil, reportError("rollbackBody is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.rollbackBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiRotateTokenRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	id string
+	rotateBearerTokenInputBody *RotateBearerTokenInputBody
+}
+
+func (r ApiRotateTokenRequest) RotateBearerTokenInputBody(rotateBearerTokenInputBody RotateBearerTokenInputBody) ApiRotateTokenRequest {
+	r.rotateBearerTokenInputBody = &rotateBearerTokenInputBody
+	return r
+}
+
+func (r ApiRotateTokenRequest) Execute() (*CreateBearerTokenOutputBody, *http.Response, error) {
+	return r.ApiService.RotateTokenExecute(r)
+}
+
+/*
+RotateToken Method for RotateToken
+
+Replace the secret part of a bearer token. The old value stops working right away; the token keeps its ID, name, and permissions.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id
+ @return ApiRotateTokenRequest
+*/
+func (a *DefaultAPIService) RotateToken(ctx context.Context, id string) ApiRotateTokenRequest {
+	return ApiRotateTokenRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return CreateBearerTokenOutputBody
+func (a *DefaultAPIService) RotateTokenExecute(r ApiRotateTokenRequest) (*CreateBearerTokenOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *CreateBearerTokenOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.RotateToken")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/token/{id}/rotate"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.rotateBearerTokenInputBody == nil {
+		return localVarReturnValue, nil, reportError("rotateBearerTokenInputBody is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.rotateBearerTokenInputBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiStartGithubLoginRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+}
+
+func (r ApiStartGithubLoginRequest) Execute() (*StartGithubLoginOutputBody, *http.Response, error) {
+	return r.ApiService.StartGithubLoginExecute(r)
+}
+
+/*
+StartGithubLogin Method for StartGithubLogin
+
+Start logging into the admin dashboard as a registered Github user with Github's device flow. The user has to enter the user code at the verification URI, and then the finish endpoint can be called with the device code.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiStartGithubLoginRequest
+*/
+func (a *DefaultAPIService) StartGithubLogin(ctx context.Context) ApiStartGithubLoginRequest {
+	return ApiStartGithubLoginRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return StartGithubLoginOutputBody
+func (a *DefaultAPIService) StartGithubLoginExecute(r ApiStartGithubLoginRequest) (*StartGithubLoginOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *StartGithubLoginOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.StartGithubLogin")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/session/github/start"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v ErrorModel
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiUploadBlobsRequest struct {
+	ctx context.Context
+	ApiService *DefaultAPIService
+	blobs []*os.File
+	url *string
+}
+
+func (r ApiUploadBlobsRequest) Blobs(blobs []*os.File) ApiUploadBlobsRequest {
+	r.blobs = blobs
+	return r
+}
+
+// The URL of the deployment that the files are for.
+func (r ApiUploadBlobsRequest) Url(url string) ApiUploadBlobsRequest {
+	r.url = &url
+	return r
+}
+
+func (r ApiUploadBlobsRequest) Execute() (*UploadBlobsOutputBody, *http.Response, error) {
+	return r.ApiService.UploadBlobsExecute(r)
+}
+
+/*
+UploadBlobs Method for UploadBlobs
+
+Upload the files from a manifest that the server doesn't have yet.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiUploadBlobsRequest
+*/
+func (a *DefaultAPIService) UploadBlobs(ctx context.Context) ApiUploadBlobsRequest {
+	return ApiUploadBlobsRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return UploadBlobsOutputBody
+func (a *DefaultAPIService) UploadBlobsExecute(r ApiUploadBlobsRequest) (*UploadBlobsOutputBody, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *UploadBlobsOutputBody
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.UploadBlobs")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/deploy/blobs"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.url == nil {
+		return localVarReturnValue, nil, reportError("url is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"multipart/form-data"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	var blobsLocalVarFormFileName string
+	var blobsLocalVarFileName     string
+	var blobsLocalVarFileBytes    []byte
+
+	blobsLocalVarFormFileName = "blobs"
+	blobsLocalVarFile := r.blobs
+
+	if blobsLocalVarFile != nil {
+		// loop through the array to prepare multiple files upload
+		for _, blobsLocalVarFileValue := range blobsLocalVarFile {
+			fbs, _ := io.ReadAll(blobsLocalVarFileValue)
+
+			blobsLocalVarFileBytes = fbs
+			blobsLocalVarFileName = blobsLocalVarFileValue.Name()
+			blobsLocalVarFileValue.Close()
+			formFiles = append(formFiles, formFile{fileBytes: blobsLocalVarFileBytes, fileName: blobsLocalVarFileName, formFileName: blobsLocalVarFormFileName})
+		}
+	}
+	parameterAddToHeaderOrQuery(localVarFormParams, "url", r.url, "form", "")
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
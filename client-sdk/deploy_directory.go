@@ -0,0 +1,135 @@
+/*
+Internet Golf API
+
+This file is not generated; it adds a convenience method on top of the
+generated API client.
+*/
+
+package golfsdk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// limits for how much is sent in each UploadBlobs request, since the client
+// holds a whole request in memory while sending it
+const (
+	maxBlobsPerUpload     = 100
+	maxBlobBytesPerUpload = 32 * 1024 * 1024
+)
+
+type localFile struct {
+	path string
+	size int64
+}
+
+/*
+DeployDirectory uploads the contents of a local directory to an existing
+deployment.
+
+It sends the server a manifest of the directory's files and their SHA-256
+hashes, uploads only the files whose contents the server doesn't already have,
+and then deploys the manifest.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc.
+	@param url The URL of the deployment that you're updating.
+	@param dir The local directory whose contents should be deployed.
+*/
+func (a *DefaultAPIService) DeployDirectory(ctx context.Context, url string, dir string) (*SuccessOutputBody, *http.Response, error) {
+	manifest := ManifestBody{Url: url, Files: []ManifestFileModel{}}
+	filesByHash := map[string]localFile{}
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		// follows symlinks, so that linked files are deployed as regular files
+		info, err := os.Stat(p)
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		hash, err := hashFile(p)
+		if err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, ManifestFileModel{Path: filepath.ToSlash(relPath), Hash: hash})
+		filesByHash[hash] = localFile{path: p, size: info.Size()}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	check, resp, err := a.CheckManifest(ctx).ManifestBody(manifest).Execute()
+	if err != nil {
+		return nil, resp, err
+	}
+
+	batch := []localFile{}
+	var batchBytes int64
+	for i, hash := range check.Missing {
+		file, ok := filesByHash[hash]
+		if !ok {
+			continue
+		}
+		batch = append(batch, file)
+		batchBytes += file.size
+		isLast := i == len(check.Missing)-1
+		if len(batch) >= maxBlobsPerUpload || batchBytes >= maxBlobBytesPerUpload || isLast {
+			if resp, err := a.uploadBlobBatch(ctx, url, batch); err != nil {
+				return nil, resp, err
+			}
+			batch = []localFile{}
+			batchBytes = 0
+		}
+	}
+
+	return a.DeployManifest(ctx).ManifestBody(manifest).Execute()
+}
+
+func (a *DefaultAPIService) uploadBlobBatch(ctx context.Context, url string, batch []localFile) (*http.Response, error) {
+	if len(batch) == 0 {
+		return nil, nil
+	}
+	openFiles := []*os.File{}
+	for _, file := range batch {
+		f, err := os.Open(file.path)
+		if err != nil {
+			for _, opened := range openFiles {
+				opened.Close()
+			}
+			return nil, err
+		}
+		openFiles = append(openFiles, f)
+	}
+	// the generated client closes the files after reading them
+	_, resp, err := a.UploadBlobs(ctx).Url(url).Blobs(openFiles).Execute()
+	return resp, err
+}
+
+func hashFile(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hashWriter := sha256.New()
+	if _, err := io.Copy(hashWriter, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hashWriter.Sum(nil)), nil
+}
@@ -26,6 +26,8 @@ type AddExternalUserInputBody struct {
 	ExternalUserHandle *string `json:"externalUserHandle,omitempty"`
 	ExternalUserId *string `json:"externalUserId,omitempty"`
 	ExternalUserSource string `json:"externalUserSource"`
+	// What the user can do. If there aren't any scopes, the user gets full permissions.
+	Scopes []ScopeModel `json:"scopes,omitempty"`
 }
 
 type _AddExternalUserInputBody AddExternalUserInputBody
@@ -168,6 +170,39 @@ func (o *AddExternalUserInputBody) SetExternalUserSource(v string) {
 	o.ExternalUserSource = v
 }
 
+// GetScopes returns the Scopes field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *AddExternalUserInputBody) GetScopes() []ScopeModel {
+	if o == nil {
+		var ret []ScopeModel
+		return ret
+	}
+	return o.Scopes
+}
+
+// GetScopesOk returns a tuple with the Scopes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *AddExternalUserInputBody) GetScopesOk() ([]ScopeModel, bool) {
+	if o == nil || IsNil(o.Scopes) {
+		return nil, false
+	}
+	return o.Scopes, true
+}
+
+// HasScopes returns a boolean if a field has been set.
+func (o *AddExternalUserInputBody) HasScopes() bool {
+	if o != nil && !IsNil(o.Scopes) {
+		return true
+	}
+
+	return false
+}
+
+// SetScopes gets a reference to the given []ScopeModel and assigns it to the Scopes field.
+func (o *AddExternalUserInputBody) SetScopes(v []ScopeModel) {
+	o.Scopes = v
+}
+
 func (o AddExternalUserInputBody) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -188,6 +223,9 @@ func (o AddExternalUserInputBody) ToMap() (map[string]interface{}, error) {
 		toSerialize["externalUserId"] = o.ExternalUserId
 	}
 	toSerialize["externalUserSource"] = o.ExternalUserSource
+	if o.Scopes != nil {
+		toSerialize["scopes"] = o.Scopes
+	}
 	return toSerialize, nil
 }
 
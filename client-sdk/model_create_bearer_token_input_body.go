@@ -23,7 +23,14 @@ var _ MappedNullable = &CreateBearerTokenInputBody{}
 type CreateBearerTokenInputBody struct {
 	// A URL to the JSON Schema for this object.
 	Schema *string `json:"$schema,omitempty"`
+	// How long until the token stops working, like \"720h\". If this isn't set, the token works until it's revoked.
+	ExpiresIn *string `json:"expiresIn,omitempty"`
+	// Whether the token can do anything, including creating more credentials. If this is false, the token can only do what its scopes allow.
 	FullPermissions bool `json:"fullPermissions"`
+	// Name for the token, to help tell it apart from the others. This is just metadata.
+	Name *string `json:"name,omitempty"`
+	// What the token can do, if it doesn't have full permissions.
+	Scopes []ScopeModel `json:"scopes,omitempty"`
 }
 
 type _CreateBearerTokenInputBody CreateBearerTokenInputBody
@@ -78,6 +85,38 @@ func (o *CreateBearerTokenInputBody) SetSchema(v string) {
 	o.Schema = &v
 }
 
+// GetExpiresIn returns the ExpiresIn field value if set, zero value otherwise.
+func (o *CreateBearerTokenInputBody) GetExpiresIn() string {
+	if o == nil || IsNil(o.ExpiresIn) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresIn
+}
+
+// GetExpiresInOk returns a tuple with the ExpiresIn field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateBearerTokenInputBody) GetExpiresInOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresIn) {
+		return nil, false
+	}
+	return o.ExpiresIn, true
+}
+
+// HasExpiresIn returns a boolean if a field has been set.
+func (o *CreateBearerTokenInputBody) HasExpiresIn() bool {
+	if o != nil && !IsNil(o.ExpiresIn) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresIn gets a reference to the given string and assigns it to the ExpiresIn field.
+func (o *CreateBearerTokenInputBody) SetExpiresIn(v string) {
+	o.ExpiresIn = &v
+}
+
 // GetFullPermissions returns the FullPermissions field value
 func (o *CreateBearerTokenInputBody) GetFullPermissions() bool {
 	if o == nil {
@@ -102,6 +141,71 @@ func (o *CreateBearerTokenInputBody) SetFullPermissions(v bool) {
 	o.FullPermissions = v
 }
 
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *CreateBearerTokenInputBody) GetName() string {
+	if o == nil || IsNil(o.Name) {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetNameOk returns a tuple with the Name field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateBearerTokenInputBody) GetNameOk() (*string, bool) {
+	if o == nil || IsNil(o.Name) {
+		return nil, false
+	}
+	return o.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (o *CreateBearerTokenInputBody) HasName() bool {
+	if o != nil && !IsNil(o.Name) {
+		return true
+	}
+
+	return false
+}
+
+// SetName gets a reference to the given string and assigns it to the Name field.
+func (o *CreateBearerTokenInputBody) SetName(v string) {
+	o.Name = &v
+}
+
+// GetScopes returns the Scopes field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *CreateBearerTokenInputBody) GetScopes() []ScopeModel {
+	if o == nil {
+		var ret []ScopeModel
+		return ret
+	}
+	return o.Scopes
+}
+
+// GetScopesOk returns a tuple with the Scopes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *CreateBearerTokenInputBody) GetScopesOk() ([]ScopeModel, bool) {
+	if o == nil || IsNil(o.Scopes) {
+		return nil, false
+	}
+	return o.Scopes, true
+}
+
+// HasScopes returns a boolean if a field has been set.
+func (o *CreateBearerTokenInputBody) HasScopes() bool {
+	if o != nil && !IsNil(o.Scopes) {
+		return true
+	}
+
+	return false
+}
+
+// SetScopes gets a reference to the given []ScopeModel and assigns it to the Scopes field.
+func (o *CreateBearerTokenInputBody) SetScopes(v []ScopeModel) {
+	o.Scopes = v
+}
+
 func (o CreateBearerTokenInputBody) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -115,7 +219,16 @@ func (o CreateBearerTokenInputBody) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Schema) {
 		toSerialize["$schema"] = o.Schema
 	}
+	if !IsNil(o.ExpiresIn) {
+		toSerialize["expiresIn"] = o.ExpiresIn
+	}
 	toSerialize["fullPermissions"] = o.FullPermissions
+	if !IsNil(o.Name) {
+		toSerialize["name"] = o.Name
+	}
+	if o.Scopes != nil {
+		toSerialize["scopes"] = o.Scopes
+	}
 	return toSerialize, nil
 }
 
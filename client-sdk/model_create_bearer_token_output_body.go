@@ -23,6 +23,8 @@ var _ MappedNullable = &CreateBearerTokenOutputBody{}
 type CreateBearerTokenOutputBody struct {
 	// A URL to the JSON Schema for this object.
 	Schema *string `json:"$schema,omitempty"`
+	// ID of the token, which can be used to revoke or rotate it. This is also the part of the token before the period.
+	Id string `json:"id"`
 	Token string `json:"token"`
 }
 
@@ -32,8 +34,9 @@ type _CreateBearerTokenOutputBody CreateBearerTokenOutputBody
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewCreateBearerTokenOutputBody(token string) *CreateBearerTokenOutputBody {
+func NewCreateBearerTokenOutputBody(id string, token string) *CreateBearerTokenOutputBody {
 	this := CreateBearerTokenOutputBody{}
+	this.Id = id
 	this.Token = token
 	return &this
 }
@@ -78,6 +81,30 @@ func (o *CreateBearerTokenOutputBody) SetSchema(v string) {
 	o.Schema = &v
 }
 
+// GetId returns the Id field value
+func (o *CreateBearerTokenOutputBody) GetId() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Id
+}
+
+// GetIdOk returns a tuple with the Id field value
+// and a boolean to check if the value has been set.
+func (o *CreateBearerTokenOutputBody) GetIdOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Id, true
+}
+
+// SetId sets field value
+func (o *CreateBearerTokenOutputBody) SetId(v string) {
+	o.Id = v
+}
+
 // GetToken returns the Token field value
 func (o *CreateBearerTokenOutputBody) GetToken() string {
 	if o == nil {
@@ -115,6 +142,7 @@ func (o CreateBearerTokenOutputBody) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Schema) {
 		toSerialize["$schema"] = o.Schema
 	}
+	toSerialize["id"] = o.Id
 	toSerialize["token"] = o.Token
 	return toSerialize, nil
 }
@@ -124,6 +152,7 @@ func (o *CreateBearerTokenOutputBody) UnmarshalJSON(data []byte) (err error) {
 	// by unmarshalling the object into a generic map with string keys and checking
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
+		"id",
 		"token",
 	}
 
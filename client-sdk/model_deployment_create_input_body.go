@@ -23,14 +23,32 @@ var _ MappedNullable = &DeploymentCreateInputBody{}
 type DeploymentCreateInputBody struct {
 	// A URL to the JSON Schema for this object.
 	Schema *string `json:"$schema,omitempty"`
+	// If there are any of these, only requests from these IP addresses or ranges (in CIDR notation) can get into this deployment.
+	AllowedIps []string `json:"allowedIps,omitempty"`
+	// Users who can get into this deployment with HTTP basic auth. If there are any, the deployment isn't public anymore; visitors need one of these usernames and passwords (or the share token).
+	BasicAuthUsers []BasicAuthUserModel `json:"basicAuthUsers,omitempty"`
+	// Requests from these IP addresses or ranges (in CIDR notation) can't get into this deployment.
+	DeniedIps []string `json:"deniedIps,omitempty"`
+	// Pages from a static deployment's files to show when it responds with an error. The keys are status codes like \"404\", or ranges of them like \"5xx\" or \"50x\", and the values are the paths to the pages. More specific status codes take precedence over ranges.
+	ErrorPages map[string]string `json:"errorPages,omitempty"`
 	// Original repository for this deployment's source. Can include a branch name.
 	ExternalSource *string `json:"externalSource,omitempty"`
-	// Place where the original repository lives.
+	// Place where the original repository lives. This has to be the name of one of the server's OIDC issuers; \"Github\" always works.
 	ExternalSourceType *string `json:"externalSourceType,omitempty"`
+	// Changes to make to the headers of responses from this deployment, in order. These are applied after the security headers, so they can override them.
+	HeaderRules []HeaderRuleModel `json:"headerRules,omitempty"`
 	// Name for the deployment. This is just metadata; make it whatever you want.
-	Name string `json:"name"`
+	Name *string `json:"name,omitempty"`
 	// If this is true and the deployment url has a path like \"/thing\", then the \"/thing\" in the path will be transparently passed through to the underlying resource instead of being removed (which is the default)
 	PreserveExternalPath *bool `json:"preserveExternalPath,omitempty"`
+	// If this is set, anyone who can deploy this deployment's repository (including from other branches) can create preview deployments at subdomains of this domain. It has to be the deployment's own domain or a subdomain of it, and no other deployment can be using it.
+	PreviewDomain *string `json:"previewDomain,omitempty"`
+	// How long preview deployments last before they are deleted, like \"72h\". Defaults to one week.
+	PreviewTtl *string `json:"previewTtl,omitempty"`
+	// Presets for common security headers to add to responses from this deployment. \"hsts\" sets Strict-Transport-Security, \"csp\" sets a strict Content-Security-Policy that only allows resources from the deployment's own origin, \"frame-options\" sets X-Frame-Options to SAMEORIGIN, \"content-type-options\" sets X-Content-Type-Options to nosniff, and \"referrer-policy\" sets Referrer-Policy to strict-origin-when-cross-origin.
+	SecurityHeaders []string `json:"securityHeaders,omitempty"`
+	// If this is set, the deployment isn't public anymore, but anyone with a link that has this token as its golf_share query parameter can get in. After they visit the link, the token is kept in a cookie. It has to be at least 16 characters long, and can only have letters, numbers, _, and -. It's only included in responses for callers who can modify the deployment.
+	ShareToken *string `json:"shareToken,omitempty"`
 	// Tags used for metadata.
 	Tags []string `json:"tags,omitempty"`
 	// URL that this deployment will appear at. The DNS for the domain has to be set up first.
@@ -43,9 +61,8 @@ type _DeploymentCreateInputBody DeploymentCreateInputBody
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewDeploymentCreateInputBody(name string, url string) *DeploymentCreateInputBody {
+func NewDeploymentCreateInputBody(url string) *DeploymentCreateInputBody {
 	this := DeploymentCreateInputBody{}
-	this.Name = name
 	this.Url = url
 	return &this
 }
@@ -90,6 +107,137 @@ func (o *DeploymentCreateInputBody) SetSchema(v string) {
 	o.Schema = &v
 }
 
+// GetAllowedIps returns the AllowedIps field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentCreateInputBody) GetAllowedIps() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.AllowedIps
+}
+
+// GetAllowedIpsOk returns a tuple with the AllowedIps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentCreateInputBody) GetAllowedIpsOk() ([]string, bool) {
+	if o == nil || IsNil(o.AllowedIps) {
+		return nil, false
+	}
+	return o.AllowedIps, true
+}
+
+// HasAllowedIps returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasAllowedIps() bool {
+	if o != nil && !IsNil(o.AllowedIps) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowedIps gets a reference to the given []string and assigns it to the AllowedIps field.
+func (o *DeploymentCreateInputBody) SetAllowedIps(v []string) {
+	o.AllowedIps = v
+}
+
+// GetBasicAuthUsers returns the BasicAuthUsers field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentCreateInputBody) GetBasicAuthUsers() []BasicAuthUserModel {
+	if o == nil {
+		var ret []BasicAuthUserModel
+		return ret
+	}
+	return o.BasicAuthUsers
+}
+
+// GetBasicAuthUsersOk returns a tuple with the BasicAuthUsers field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentCreateInputBody) GetBasicAuthUsersOk() ([]BasicAuthUserModel, bool) {
+	if o == nil || IsNil(o.BasicAuthUsers) {
+		return nil, false
+	}
+	return o.BasicAuthUsers, true
+}
+
+// HasBasicAuthUsers returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasBasicAuthUsers() bool {
+	if o != nil && !IsNil(o.BasicAuthUsers) {
+		return true
+	}
+
+	return false
+}
+
+// SetBasicAuthUsers gets a reference to the given []BasicAuthUserModel and assigns it to the BasicAuthUsers field.
+func (o *DeploymentCreateInputBody) SetBasicAuthUsers(v []BasicAuthUserModel) {
+	o.BasicAuthUsers = v
+}
+
+// GetDeniedIps returns the DeniedIps field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentCreateInputBody) GetDeniedIps() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.DeniedIps
+}
+
+// GetDeniedIpsOk returns a tuple with the DeniedIps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentCreateInputBody) GetDeniedIpsOk() ([]string, bool) {
+	if o == nil || IsNil(o.DeniedIps) {
+		return nil, false
+	}
+	return o.DeniedIps, true
+}
+
+// HasDeniedIps returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasDeniedIps() bool {
+	if o != nil && !IsNil(o.DeniedIps) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeniedIps gets a reference to the given []string and assigns it to the DeniedIps field.
+func (o *DeploymentCreateInputBody) SetDeniedIps(v []string) {
+	o.DeniedIps = v
+}
+
+// GetErrorPages returns the ErrorPages field value if set, zero value otherwise.
+func (o *DeploymentCreateInputBody) GetErrorPages() map[string]string {
+	if o == nil || IsNil(o.ErrorPages) {
+		var ret map[string]string
+		return ret
+	}
+	return o.ErrorPages
+}
+
+// GetErrorPagesOk returns a tuple with the ErrorPages field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentCreateInputBody) GetErrorPagesOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.ErrorPages) {
+		return map[string]string{}, false
+	}
+	return o.ErrorPages, true
+}
+
+// HasErrorPages returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasErrorPages() bool {
+	if o != nil && !IsNil(o.ErrorPages) {
+		return true
+	}
+
+	return false
+}
+
+// SetErrorPages gets a reference to the given map[string]string and assigns it to the ErrorPages field.
+func (o *DeploymentCreateInputBody) SetErrorPages(v map[string]string) {
+	o.ErrorPages = v
+}
+
 // GetExternalSource returns the ExternalSource field value if set, zero value otherwise.
 func (o *DeploymentCreateInputBody) GetExternalSource() string {
 	if o == nil || IsNil(o.ExternalSource) {
@@ -154,28 +302,69 @@ func (o *DeploymentCreateInputBody) SetExternalSourceType(v string) {
 	o.ExternalSourceType = &v
 }
 
-// GetName returns the Name field value
-func (o *DeploymentCreateInputBody) GetName() string {
+// GetHeaderRules returns the HeaderRules field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentCreateInputBody) GetHeaderRules() []HeaderRuleModel {
 	if o == nil {
-		var ret string
+		var ret []HeaderRuleModel
 		return ret
 	}
+	return o.HeaderRules
+}
 
-	return o.Name
+// GetHeaderRulesOk returns a tuple with the HeaderRules field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentCreateInputBody) GetHeaderRulesOk() ([]HeaderRuleModel, bool) {
+	if o == nil || IsNil(o.HeaderRules) {
+		return nil, false
+	}
+	return o.HeaderRules, true
 }
 
-// GetNameOk returns a tuple with the Name field value
+// HasHeaderRules returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasHeaderRules() bool {
+	if o != nil && !IsNil(o.HeaderRules) {
+		return true
+	}
+
+	return false
+}
+
+// SetHeaderRules gets a reference to the given []HeaderRuleModel and assigns it to the HeaderRules field.
+func (o *DeploymentCreateInputBody) SetHeaderRules(v []HeaderRuleModel) {
+	o.HeaderRules = v
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *DeploymentCreateInputBody) GetName() string {
+	if o == nil || IsNil(o.Name) {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetNameOk returns a tuple with the Name field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *DeploymentCreateInputBody) GetNameOk() (*string, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.Name) {
 		return nil, false
 	}
-	return &o.Name, true
+	return o.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasName() bool {
+	if o != nil && !IsNil(o.Name) {
+		return true
+	}
+
+	return false
 }
 
-// SetName sets field value
+// SetName gets a reference to the given string and assigns it to the Name field.
 func (o *DeploymentCreateInputBody) SetName(v string) {
-	o.Name = v
+	o.Name = &v
 }
 
 // GetPreserveExternalPath returns the PreserveExternalPath field value if set, zero value otherwise.
@@ -210,6 +399,135 @@ func (o *DeploymentCreateInputBody) SetPreserveExternalPath(v bool) {
 	o.PreserveExternalPath = &v
 }
 
+// GetPreviewDomain returns the PreviewDomain field value if set, zero value otherwise.
+func (o *DeploymentCreateInputBody) GetPreviewDomain() string {
+	if o == nil || IsNil(o.PreviewDomain) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewDomain
+}
+
+// GetPreviewDomainOk returns a tuple with the PreviewDomain field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentCreateInputBody) GetPreviewDomainOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewDomain) {
+		return nil, false
+	}
+	return o.PreviewDomain, true
+}
+
+// HasPreviewDomain returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasPreviewDomain() bool {
+	if o != nil && !IsNil(o.PreviewDomain) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewDomain gets a reference to the given string and assigns it to the PreviewDomain field.
+func (o *DeploymentCreateInputBody) SetPreviewDomain(v string) {
+	o.PreviewDomain = &v
+}
+
+// GetPreviewTtl returns the PreviewTtl field value if set, zero value otherwise.
+func (o *DeploymentCreateInputBody) GetPreviewTtl() string {
+	if o == nil || IsNil(o.PreviewTtl) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewTtl
+}
+
+// GetPreviewTtlOk returns a tuple with the PreviewTtl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentCreateInputBody) GetPreviewTtlOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewTtl) {
+		return nil, false
+	}
+	return o.PreviewTtl, true
+}
+
+// HasPreviewTtl returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasPreviewTtl() bool {
+	if o != nil && !IsNil(o.PreviewTtl) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewTtl gets a reference to the given string and assigns it to the PreviewTtl field.
+func (o *DeploymentCreateInputBody) SetPreviewTtl(v string) {
+	o.PreviewTtl = &v
+}
+
+// GetSecurityHeaders returns the SecurityHeaders field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentCreateInputBody) GetSecurityHeaders() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.SecurityHeaders
+}
+
+// GetSecurityHeadersOk returns a tuple with the SecurityHeaders field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentCreateInputBody) GetSecurityHeadersOk() ([]string, bool) {
+	if o == nil || IsNil(o.SecurityHeaders) {
+		return nil, false
+	}
+	return o.SecurityHeaders, true
+}
+
+// HasSecurityHeaders returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasSecurityHeaders() bool {
+	if o != nil && !IsNil(o.SecurityHeaders) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecurityHeaders gets a reference to the given []string and assigns it to the SecurityHeaders field.
+func (o *DeploymentCreateInputBody) SetSecurityHeaders(v []string) {
+	o.SecurityHeaders = v
+}
+
+// GetShareToken returns the ShareToken field value if set, zero value otherwise.
+func (o *DeploymentCreateInputBody) GetShareToken() string {
+	if o == nil || IsNil(o.ShareToken) {
+		var ret string
+		return ret
+	}
+	return *o.ShareToken
+}
+
+// GetShareTokenOk returns a tuple with the ShareToken field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentCreateInputBody) GetShareTokenOk() (*string, bool) {
+	if o == nil || IsNil(o.ShareToken) {
+		return nil, false
+	}
+	return o.ShareToken, true
+}
+
+// HasShareToken returns a boolean if a field has been set.
+func (o *DeploymentCreateInputBody) HasShareToken() bool {
+	if o != nil && !IsNil(o.ShareToken) {
+		return true
+	}
+
+	return false
+}
+
+// SetShareToken gets a reference to the given string and assigns it to the ShareToken field.
+func (o *DeploymentCreateInputBody) SetShareToken(v string) {
+	o.ShareToken = &v
+}
+
 // GetTags returns the Tags field value if set, zero value otherwise (both if not set or set to explicit null).
 func (o *DeploymentCreateInputBody) GetTags() []string {
 	if o == nil {
@@ -280,16 +598,45 @@ func (o DeploymentCreateInputBody) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Schema) {
 		toSerialize["$schema"] = o.Schema
 	}
+	if o.AllowedIps != nil {
+		toSerialize["allowedIps"] = o.AllowedIps
+	}
+	if o.BasicAuthUsers != nil {
+		toSerialize["basicAuthUsers"] = o.BasicAuthUsers
+	}
+	if o.DeniedIps != nil {
+		toSerialize["deniedIps"] = o.DeniedIps
+	}
+	if !IsNil(o.ErrorPages) {
+		toSerialize["errorPages"] = o.ErrorPages
+	}
 	if !IsNil(o.ExternalSource) {
 		toSerialize["externalSource"] = o.ExternalSource
 	}
 	if !IsNil(o.ExternalSourceType) {
 		toSerialize["externalSourceType"] = o.ExternalSourceType
 	}
-	toSerialize["name"] = o.Name
+	if o.HeaderRules != nil {
+		toSerialize["headerRules"] = o.HeaderRules
+	}
+	if !IsNil(o.Name) {
+		toSerialize["name"] = o.Name
+	}
 	if !IsNil(o.PreserveExternalPath) {
 		toSerialize["preserveExternalPath"] = o.PreserveExternalPath
 	}
+	if !IsNil(o.PreviewDomain) {
+		toSerialize["previewDomain"] = o.PreviewDomain
+	}
+	if !IsNil(o.PreviewTtl) {
+		toSerialize["previewTtl"] = o.PreviewTtl
+	}
+	if o.SecurityHeaders != nil {
+		toSerialize["securityHeaders"] = o.SecurityHeaders
+	}
+	if !IsNil(o.ShareToken) {
+		toSerialize["shareToken"] = o.ShareToken
+	}
 	if o.Tags != nil {
 		toSerialize["tags"] = o.Tags
 	}
@@ -302,7 +649,6 @@ func (o *DeploymentCreateInputBody) UnmarshalJSON(data []byte) (err error) {
 	// by unmarshalling the object into a generic map with string keys and checking
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
-		"name",
 		"url",
 	}
 
@@ -23,23 +23,61 @@ var _ MappedNullable = &DeploymentModel{}
 type DeploymentModel struct {
 	// The URL that this deployment is an alias for.
 	AliasedTo *string `json:"aliasedTo,omitempty"`
+	// If there are any of these, only requests from these IP addresses or ranges (in CIDR notation) can get into this deployment.
+	AllowedIps []string `json:"allowedIps,omitempty"`
+	// Users who can get into this deployment with HTTP basic auth. If there are any, the deployment isn't public anymore; visitors need one of these usernames and passwords (or the share token).
+	BasicAuthUsers []BasicAuthUserModel `json:"basicAuthUsers,omitempty"`
+	// The port that the app inside the container listens on.
+	ContainerPort *int64 `json:"containerPort,omitempty"`
 	// When the deployment was created (string in ISO-8601 format.)
 	CreatedAt string `json:"createdAt"`
+	// Requests from these IP addresses or ranges (in CIDR notation) can't get into this deployment.
+	DeniedIps []string `json:"deniedIps,omitempty"`
+	// Pages from a static deployment's files to show when it responds with an error. The keys are status codes like \"404\", or ranges of them like \"5xx\" or \"50x\", and the values are the paths to the pages. More specific status codes take precedence over ranges.
+	ErrorPages map[string]string `json:"errorPages,omitempty"`
+	// The path to the executable that this deployment runs on the server.
+	Executable *string `json:"executable,omitempty"`
+	// If this is a preview deployment, when it will be deleted (string in ISO-8601 format.)
+	ExpiresAt *string `json:"expiresAt,omitempty"`
 	// Original repository for this deployment's source. Can include a branch name.
 	ExternalSource *string `json:"externalSource,omitempty"`
-	// Place where the original repository lives.
+	// Place where the original repository lives. This has to be the name of one of the server's OIDC issuers; \"Github\" always works.
 	ExternalSourceType *string `json:"externalSourceType,omitempty"`
+	// Changes to make to the headers of responses from this deployment, in order. These are applied after the security headers, so they can override them.
+	HeaderRules []HeaderRuleModel `json:"headerRules,omitempty"`
+	// Request headers to set on requests to the upstreams. Host and X-Forwarded-* can't be set.
+	Headers map[string]string `json:"headers,omitempty"`
+	// How often to perform health checks, like \"10s\". Defaults to 30 seconds.
+	HealthCheckInterval *string `json:"healthCheckInterval,omitempty"`
+	// If set, this path is periodically requested from each upstream, and upstreams that don't respond successfully stop receiving traffic.
+	HealthCheckPath *string `json:"healthCheckPath,omitempty"`
+	// The Docker image that the deployment's container is running.
+	Image *string `json:"image,omitempty"`
+	// How requests are distributed between the upstreams. Defaults to random.
+	LoadBalancing *string `json:"loadBalancing,omitempty"`
 	Meta SiteMeta `json:"meta"`
 	// Name for the deployment. This is just metadata; make it whatever you want.
-	Name string `json:"name"`
+	Name *string `json:"name,omitempty"`
 	// Set to true to indicate that this deployment has not yet been set up.
 	NoContentYet *bool `json:"noContentYet,omitempty"`
 	// If this is true and the deployment url has a path like \"/thing\", then the \"/thing\" in the path will be transparently passed through to the underlying resource instead of being removed (which is the default)
 	PreserveExternalPath *bool `json:"preserveExternalPath,omitempty"`
+	// If this is set, anyone who can deploy this deployment's repository (including from other branches) can create preview deployments at subdomains of this domain. It has to be the deployment's own domain or a subdomain of it, and no other deployment can be using it.
+	PreviewDomain *string `json:"previewDomain,omitempty"`
+	// If this is a preview deployment, the URL of the deployment that it's a preview of.
+	PreviewOf *string `json:"previewOf,omitempty"`
+	// How long preview deployments last before they are deleted, like \"72h\". Defaults to one week.
+	PreviewTtl *string `json:"previewTtl,omitempty"`
 	// If this is true, visitors to this deployment's URL will be completely redirected to the URL that this alias is for.
 	Redirect *bool `json:"redirect,omitempty"`
+	// Presets for common security headers to add to responses from this deployment. \"hsts\" sets Strict-Transport-Security, \"csp\" sets a strict Content-Security-Policy that only allows resources from the deployment's own origin, \"frame-options\" sets X-Frame-Options to SAMEORIGIN, \"content-type-options\" sets X-Content-Type-Options to nosniff, and \"referrer-policy\" sets Referrer-Policy to strict-origin-when-cross-origin.
+	SecurityHeaders []string `json:"securityHeaders,omitempty"`
 	// The path to this deployment's files on the server.
 	ServerContentLocation *string `json:"serverContentLocation,omitempty"`
+	// If this is set, the deployment isn't public anymore, but anyone with a link that has this token as its golf_share query parameter can get in. After they visit the link, the token is kept in a cookie. It has to be at least 16 characters long, and can only have letters, numbers, _, and -. It's only included in responses for callers who can modify the deployment.
+	ShareToken *string `json:"shareToken,omitempty"`
+	// Whether the deployment has a share token. The token itself is only included for callers who can modify the deployment.
+	ShareTokenSet bool `json:"shareTokenSet"`
 	// Whether this deployment is set up to support a Single Page App by using /index.html as a fallback for all requests.
 	SpaMode *bool `json:"spaMode,omitempty"`
 	// Tags used for metadata.
@@ -48,6 +86,8 @@ type DeploymentModel struct {
 	Type string `json:"type"`
 	// When the deployment was last updated (string in ISO-8601 format.)
 	UpdatedAt string `json:"updatedAt"`
+	// The servers that requests are proxied to, in host:port format.
+	Upstreams []string `json:"upstreams,omitempty"`
 	// URL that this deployment will appear at. The DNS for the domain has to be set up first.
 	Url string `json:"url"`
 }
@@ -58,11 +98,11 @@ type _DeploymentModel DeploymentModel
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewDeploymentModel(createdAt string, meta SiteMeta, name string, type_ string, updatedAt string, url string) *DeploymentModel {
+func NewDeploymentModel(createdAt string, meta SiteMeta, shareTokenSet bool, type_ string, updatedAt string, url string) *DeploymentModel {
 	this := DeploymentModel{}
 	this.CreatedAt = createdAt
 	this.Meta = meta
-	this.Name = name
+	this.ShareTokenSet = shareTokenSet
 	this.Type = type_
 	this.UpdatedAt = updatedAt
 	this.Url = url
@@ -109,6 +149,104 @@ func (o *DeploymentModel) SetAliasedTo(v string) {
 	o.AliasedTo = &v
 }
 
+// GetAllowedIps returns the AllowedIps field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentModel) GetAllowedIps() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.AllowedIps
+}
+
+// GetAllowedIpsOk returns a tuple with the AllowedIps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentModel) GetAllowedIpsOk() ([]string, bool) {
+	if o == nil || IsNil(o.AllowedIps) {
+		return nil, false
+	}
+	return o.AllowedIps, true
+}
+
+// HasAllowedIps returns a boolean if a field has been set.
+func (o *DeploymentModel) HasAllowedIps() bool {
+	if o != nil && !IsNil(o.AllowedIps) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowedIps gets a reference to the given []string and assigns it to the AllowedIps field.
+func (o *DeploymentModel) SetAllowedIps(v []string) {
+	o.AllowedIps = v
+}
+
+// GetBasicAuthUsers returns the BasicAuthUsers field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentModel) GetBasicAuthUsers() []BasicAuthUserModel {
+	if o == nil {
+		var ret []BasicAuthUserModel
+		return ret
+	}
+	return o.BasicAuthUsers
+}
+
+// GetBasicAuthUsersOk returns a tuple with the BasicAuthUsers field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentModel) GetBasicAuthUsersOk() ([]BasicAuthUserModel, bool) {
+	if o == nil || IsNil(o.BasicAuthUsers) {
+		return nil, false
+	}
+	return o.BasicAuthUsers, true
+}
+
+// HasBasicAuthUsers returns a boolean if a field has been set.
+func (o *DeploymentModel) HasBasicAuthUsers() bool {
+	if o != nil && !IsNil(o.BasicAuthUsers) {
+		return true
+	}
+
+	return false
+}
+
+// SetBasicAuthUsers gets a reference to the given []BasicAuthUserModel and assigns it to the BasicAuthUsers field.
+func (o *DeploymentModel) SetBasicAuthUsers(v []BasicAuthUserModel) {
+	o.BasicAuthUsers = v
+}
+
+// GetContainerPort returns the ContainerPort field value if set, zero value otherwise.
+func (o *DeploymentModel) GetContainerPort() int64 {
+	if o == nil || IsNil(o.ContainerPort) {
+		var ret int64
+		return ret
+	}
+	return *o.ContainerPort
+}
+
+// GetContainerPortOk returns a tuple with the ContainerPort field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetContainerPortOk() (*int64, bool) {
+	if o == nil || IsNil(o.ContainerPort) {
+		return nil, false
+	}
+	return o.ContainerPort, true
+}
+
+// HasContainerPort returns a boolean if a field has been set.
+func (o *DeploymentModel) HasContainerPort() bool {
+	if o != nil && !IsNil(o.ContainerPort) {
+		return true
+	}
+
+	return false
+}
+
+// SetContainerPort gets a reference to the given int64 and assigns it to the ContainerPort field.
+func (o *DeploymentModel) SetContainerPort(v int64) {
+	o.ContainerPort = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value
 func (o *DeploymentModel) GetCreatedAt() string {
 	if o == nil {
@@ -133,6 +271,135 @@ func (o *DeploymentModel) SetCreatedAt(v string) {
 	o.CreatedAt = v
 }
 
+// GetDeniedIps returns the DeniedIps field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentModel) GetDeniedIps() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.DeniedIps
+}
+
+// GetDeniedIpsOk returns a tuple with the DeniedIps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentModel) GetDeniedIpsOk() ([]string, bool) {
+	if o == nil || IsNil(o.DeniedIps) {
+		return nil, false
+	}
+	return o.DeniedIps, true
+}
+
+// HasDeniedIps returns a boolean if a field has been set.
+func (o *DeploymentModel) HasDeniedIps() bool {
+	if o != nil && !IsNil(o.DeniedIps) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeniedIps gets a reference to the given []string and assigns it to the DeniedIps field.
+func (o *DeploymentModel) SetDeniedIps(v []string) {
+	o.DeniedIps = v
+}
+
+// GetErrorPages returns the ErrorPages field value if set, zero value otherwise.
+func (o *DeploymentModel) GetErrorPages() map[string]string {
+	if o == nil || IsNil(o.ErrorPages) {
+		var ret map[string]string
+		return ret
+	}
+	return o.ErrorPages
+}
+
+// GetErrorPagesOk returns a tuple with the ErrorPages field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetErrorPagesOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.ErrorPages) {
+		return map[string]string{}, false
+	}
+	return o.ErrorPages, true
+}
+
+// HasErrorPages returns a boolean if a field has been set.
+func (o *DeploymentModel) HasErrorPages() bool {
+	if o != nil && !IsNil(o.ErrorPages) {
+		return true
+	}
+
+	return false
+}
+
+// SetErrorPages gets a reference to the given map[string]string and assigns it to the ErrorPages field.
+func (o *DeploymentModel) SetErrorPages(v map[string]string) {
+	o.ErrorPages = v
+}
+
+// GetExecutable returns the Executable field value if set, zero value otherwise.
+func (o *DeploymentModel) GetExecutable() string {
+	if o == nil || IsNil(o.Executable) {
+		var ret string
+		return ret
+	}
+	return *o.Executable
+}
+
+// GetExecutableOk returns a tuple with the Executable field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetExecutableOk() (*string, bool) {
+	if o == nil || IsNil(o.Executable) {
+		return nil, false
+	}
+	return o.Executable, true
+}
+
+// HasExecutable returns a boolean if a field has been set.
+func (o *DeploymentModel) HasExecutable() bool {
+	if o != nil && !IsNil(o.Executable) {
+		return true
+	}
+
+	return false
+}
+
+// SetExecutable gets a reference to the given string and assigns it to the Executable field.
+func (o *DeploymentModel) SetExecutable(v string) {
+	o.Executable = &v
+}
+
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *DeploymentModel) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *DeploymentModel) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *DeploymentModel) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetExternalSource returns the ExternalSource field value if set, zero value otherwise.
 func (o *DeploymentModel) GetExternalSource() string {
 	if o == nil || IsNil(o.ExternalSource) {
@@ -197,6 +464,199 @@ func (o *DeploymentModel) SetExternalSourceType(v string) {
 	o.ExternalSourceType = &v
 }
 
+// GetHeaderRules returns the HeaderRules field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentModel) GetHeaderRules() []HeaderRuleModel {
+	if o == nil {
+		var ret []HeaderRuleModel
+		return ret
+	}
+	return o.HeaderRules
+}
+
+// GetHeaderRulesOk returns a tuple with the HeaderRules field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentModel) GetHeaderRulesOk() ([]HeaderRuleModel, bool) {
+	if o == nil || IsNil(o.HeaderRules) {
+		return nil, false
+	}
+	return o.HeaderRules, true
+}
+
+// HasHeaderRules returns a boolean if a field has been set.
+func (o *DeploymentModel) HasHeaderRules() bool {
+	if o != nil && !IsNil(o.HeaderRules) {
+		return true
+	}
+
+	return false
+}
+
+// SetHeaderRules gets a reference to the given []HeaderRuleModel and assigns it to the HeaderRules field.
+func (o *DeploymentModel) SetHeaderRules(v []HeaderRuleModel) {
+	o.HeaderRules = v
+}
+
+// GetHeaders returns the Headers field value if set, zero value otherwise.
+func (o *DeploymentModel) GetHeaders() map[string]string {
+	if o == nil || IsNil(o.Headers) {
+		var ret map[string]string
+		return ret
+	}
+	return o.Headers
+}
+
+// GetHeadersOk returns a tuple with the Headers field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetHeadersOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.Headers) {
+		return map[string]string{}, false
+	}
+	return o.Headers, true
+}
+
+// HasHeaders returns a boolean if a field has been set.
+func (o *DeploymentModel) HasHeaders() bool {
+	if o != nil && !IsNil(o.Headers) {
+		return true
+	}
+
+	return false
+}
+
+// SetHeaders gets a reference to the given map[string]string and assigns it to the Headers field.
+func (o *DeploymentModel) SetHeaders(v map[string]string) {
+	o.Headers = v
+}
+
+// GetHealthCheckInterval returns the HealthCheckInterval field value if set, zero value otherwise.
+func (o *DeploymentModel) GetHealthCheckInterval() string {
+	if o == nil || IsNil(o.HealthCheckInterval) {
+		var ret string
+		return ret
+	}
+	return *o.HealthCheckInterval
+}
+
+// GetHealthCheckIntervalOk returns a tuple with the HealthCheckInterval field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetHealthCheckIntervalOk() (*string, bool) {
+	if o == nil || IsNil(o.HealthCheckInterval) {
+		return nil, false
+	}
+	return o.HealthCheckInterval, true
+}
+
+// HasHealthCheckInterval returns a boolean if a field has been set.
+func (o *DeploymentModel) HasHealthCheckInterval() bool {
+	if o != nil && !IsNil(o.HealthCheckInterval) {
+		return true
+	}
+
+	return false
+}
+
+// SetHealthCheckInterval gets a reference to the given string and assigns it to the HealthCheckInterval field.
+func (o *DeploymentModel) SetHealthCheckInterval(v string) {
+	o.HealthCheckInterval = &v
+}
+
+// GetHealthCheckPath returns the HealthCheckPath field value if set, zero value otherwise.
+func (o *DeploymentModel) GetHealthCheckPath() string {
+	if o == nil || IsNil(o.HealthCheckPath) {
+		var ret string
+		return ret
+	}
+	return *o.HealthCheckPath
+}
+
+// GetHealthCheckPathOk returns a tuple with the HealthCheckPath field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetHealthCheckPathOk() (*string, bool) {
+	if o == nil || IsNil(o.HealthCheckPath) {
+		return nil, false
+	}
+	return o.HealthCheckPath, true
+}
+
+// HasHealthCheckPath returns a boolean if a field has been set.
+func (o *DeploymentModel) HasHealthCheckPath() bool {
+	if o != nil && !IsNil(o.HealthCheckPath) {
+		return true
+	}
+
+	return false
+}
+
+// SetHealthCheckPath gets a reference to the given string and assigns it to the HealthCheckPath field.
+func (o *DeploymentModel) SetHealthCheckPath(v string) {
+	o.HealthCheckPath = &v
+}
+
+// GetImage returns the Image field value if set, zero value otherwise.
+func (o *DeploymentModel) GetImage() string {
+	if o == nil || IsNil(o.Image) {
+		var ret string
+		return ret
+	}
+	return *o.Image
+}
+
+// GetImageOk returns a tuple with the Image field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetImageOk() (*string, bool) {
+	if o == nil || IsNil(o.Image) {
+		return nil, false
+	}
+	return o.Image, true
+}
+
+// HasImage returns a boolean if a field has been set.
+func (o *DeploymentModel) HasImage() bool {
+	if o != nil && !IsNil(o.Image) {
+		return true
+	}
+
+	return false
+}
+
+// SetImage gets a reference to the given string and assigns it to the Image field.
+func (o *DeploymentModel) SetImage(v string) {
+	o.Image = &v
+}
+
+// GetLoadBalancing returns the LoadBalancing field value if set, zero value otherwise.
+func (o *DeploymentModel) GetLoadBalancing() string {
+	if o == nil || IsNil(o.LoadBalancing) {
+		var ret string
+		return ret
+	}
+	return *o.LoadBalancing
+}
+
+// GetLoadBalancingOk returns a tuple with the LoadBalancing field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetLoadBalancingOk() (*string, bool) {
+	if o == nil || IsNil(o.LoadBalancing) {
+		return nil, false
+	}
+	return o.LoadBalancing, true
+}
+
+// HasLoadBalancing returns a boolean if a field has been set.
+func (o *DeploymentModel) HasLoadBalancing() bool {
+	if o != nil && !IsNil(o.LoadBalancing) {
+		return true
+	}
+
+	return false
+}
+
+// SetLoadBalancing gets a reference to the given string and assigns it to the LoadBalancing field.
+func (o *DeploymentModel) SetLoadBalancing(v string) {
+	o.LoadBalancing = &v
+}
+
 // GetMeta returns the Meta field value
 func (o *DeploymentModel) GetMeta() SiteMeta {
 	if o == nil {
@@ -221,28 +681,36 @@ func (o *DeploymentModel) SetMeta(v SiteMeta) {
 	o.Meta = v
 }
 
-// GetName returns the Name field value
+// GetName returns the Name field value if set, zero value otherwise.
 func (o *DeploymentModel) GetName() string {
-	if o == nil {
+	if o == nil || IsNil(o.Name) {
 		var ret string
 		return ret
 	}
-
-	return o.Name
+	return *o.Name
 }
 
-// GetNameOk returns a tuple with the Name field value
+// GetNameOk returns a tuple with the Name field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *DeploymentModel) GetNameOk() (*string, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.Name) {
 		return nil, false
 	}
-	return &o.Name, true
+	return o.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (o *DeploymentModel) HasName() bool {
+	if o != nil && !IsNil(o.Name) {
+		return true
+	}
+
+	return false
 }
 
-// SetName sets field value
+// SetName gets a reference to the given string and assigns it to the Name field.
 func (o *DeploymentModel) SetName(v string) {
-	o.Name = v
+	o.Name = &v
 }
 
 // GetNoContentYet returns the NoContentYet field value if set, zero value otherwise.
@@ -309,6 +777,102 @@ func (o *DeploymentModel) SetPreserveExternalPath(v bool) {
 	o.PreserveExternalPath = &v
 }
 
+// GetPreviewDomain returns the PreviewDomain field value if set, zero value otherwise.
+func (o *DeploymentModel) GetPreviewDomain() string {
+	if o == nil || IsNil(o.PreviewDomain) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewDomain
+}
+
+// GetPreviewDomainOk returns a tuple with the PreviewDomain field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetPreviewDomainOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewDomain) {
+		return nil, false
+	}
+	return o.PreviewDomain, true
+}
+
+// HasPreviewDomain returns a boolean if a field has been set.
+func (o *DeploymentModel) HasPreviewDomain() bool {
+	if o != nil && !IsNil(o.PreviewDomain) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewDomain gets a reference to the given string and assigns it to the PreviewDomain field.
+func (o *DeploymentModel) SetPreviewDomain(v string) {
+	o.PreviewDomain = &v
+}
+
+// GetPreviewOf returns the PreviewOf field value if set, zero value otherwise.
+func (o *DeploymentModel) GetPreviewOf() string {
+	if o == nil || IsNil(o.PreviewOf) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewOf
+}
+
+// GetPreviewOfOk returns a tuple with the PreviewOf field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetPreviewOfOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewOf) {
+		return nil, false
+	}
+	return o.PreviewOf, true
+}
+
+// HasPreviewOf returns a boolean if a field has been set.
+func (o *DeploymentModel) HasPreviewOf() bool {
+	if o != nil && !IsNil(o.PreviewOf) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewOf gets a reference to the given string and assigns it to the PreviewOf field.
+func (o *DeploymentModel) SetPreviewOf(v string) {
+	o.PreviewOf = &v
+}
+
+// GetPreviewTtl returns the PreviewTtl field value if set, zero value otherwise.
+func (o *DeploymentModel) GetPreviewTtl() string {
+	if o == nil || IsNil(o.PreviewTtl) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewTtl
+}
+
+// GetPreviewTtlOk returns a tuple with the PreviewTtl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetPreviewTtlOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewTtl) {
+		return nil, false
+	}
+	return o.PreviewTtl, true
+}
+
+// HasPreviewTtl returns a boolean if a field has been set.
+func (o *DeploymentModel) HasPreviewTtl() bool {
+	if o != nil && !IsNil(o.PreviewTtl) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewTtl gets a reference to the given string and assigns it to the PreviewTtl field.
+func (o *DeploymentModel) SetPreviewTtl(v string) {
+	o.PreviewTtl = &v
+}
+
 // GetRedirect returns the Redirect field value if set, zero value otherwise.
 func (o *DeploymentModel) GetRedirect() bool {
 	if o == nil || IsNil(o.Redirect) {
@@ -341,6 +905,39 @@ func (o *DeploymentModel) SetRedirect(v bool) {
 	o.Redirect = &v
 }
 
+// GetSecurityHeaders returns the SecurityHeaders field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentModel) GetSecurityHeaders() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.SecurityHeaders
+}
+
+// GetSecurityHeadersOk returns a tuple with the SecurityHeaders field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentModel) GetSecurityHeadersOk() ([]string, bool) {
+	if o == nil || IsNil(o.SecurityHeaders) {
+		return nil, false
+	}
+	return o.SecurityHeaders, true
+}
+
+// HasSecurityHeaders returns a boolean if a field has been set.
+func (o *DeploymentModel) HasSecurityHeaders() bool {
+	if o != nil && !IsNil(o.SecurityHeaders) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecurityHeaders gets a reference to the given []string and assigns it to the SecurityHeaders field.
+func (o *DeploymentModel) SetSecurityHeaders(v []string) {
+	o.SecurityHeaders = v
+}
+
 // GetServerContentLocation returns the ServerContentLocation field value if set, zero value otherwise.
 func (o *DeploymentModel) GetServerContentLocation() string {
 	if o == nil || IsNil(o.ServerContentLocation) {
@@ -373,6 +970,62 @@ func (o *DeploymentModel) SetServerContentLocation(v string) {
 	o.ServerContentLocation = &v
 }
 
+// GetShareToken returns the ShareToken field value if set, zero value otherwise.
+func (o *DeploymentModel) GetShareToken() string {
+	if o == nil || IsNil(o.ShareToken) {
+		var ret string
+		return ret
+	}
+	return *o.ShareToken
+}
+
+// GetShareTokenOk returns a tuple with the ShareToken field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetShareTokenOk() (*string, bool) {
+	if o == nil || IsNil(o.ShareToken) {
+		return nil, false
+	}
+	return o.ShareToken, true
+}
+
+// HasShareToken returns a boolean if a field has been set.
+func (o *DeploymentModel) HasShareToken() bool {
+	if o != nil && !IsNil(o.ShareToken) {
+		return true
+	}
+
+	return false
+}
+
+// SetShareToken gets a reference to the given string and assigns it to the ShareToken field.
+func (o *DeploymentModel) SetShareToken(v string) {
+	o.ShareToken = &v
+}
+
+// GetShareTokenSet returns the ShareTokenSet field value
+func (o *DeploymentModel) GetShareTokenSet() bool {
+	if o == nil {
+		var ret bool
+		return ret
+	}
+
+	return o.ShareTokenSet
+}
+
+// GetShareTokenSetOk returns a tuple with the ShareTokenSet field value
+// and a boolean to check if the value has been set.
+func (o *DeploymentModel) GetShareTokenSetOk() (*bool, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.ShareTokenSet, true
+}
+
+// SetShareTokenSet sets field value
+func (o *DeploymentModel) SetShareTokenSet(v bool) {
+	o.ShareTokenSet = v
+}
+
 // GetSpaMode returns the SpaMode field value if set, zero value otherwise.
 func (o *DeploymentModel) GetSpaMode() bool {
 	if o == nil || IsNil(o.SpaMode) {
@@ -486,6 +1139,39 @@ func (o *DeploymentModel) SetUpdatedAt(v string) {
 	o.UpdatedAt = v
 }
 
+// GetUpstreams returns the Upstreams field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *DeploymentModel) GetUpstreams() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.Upstreams
+}
+
+// GetUpstreamsOk returns a tuple with the Upstreams field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *DeploymentModel) GetUpstreamsOk() ([]string, bool) {
+	if o == nil || IsNil(o.Upstreams) {
+		return nil, false
+	}
+	return o.Upstreams, true
+}
+
+// HasUpstreams returns a boolean if a field has been set.
+func (o *DeploymentModel) HasUpstreams() bool {
+	if o != nil && !IsNil(o.Upstreams) {
+		return true
+	}
+
+	return false
+}
+
+// SetUpstreams gets a reference to the given []string and assigns it to the Upstreams field.
+func (o *DeploymentModel) SetUpstreams(v []string) {
+	o.Upstreams = v
+}
+
 // GetUrl returns the Url field value
 func (o *DeploymentModel) GetUrl() string {
 	if o == nil {
@@ -523,27 +1209,84 @@ func (o DeploymentModel) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.AliasedTo) {
 		toSerialize["aliasedTo"] = o.AliasedTo
 	}
+	if o.AllowedIps != nil {
+		toSerialize["allowedIps"] = o.AllowedIps
+	}
+	if o.BasicAuthUsers != nil {
+		toSerialize["basicAuthUsers"] = o.BasicAuthUsers
+	}
+	if !IsNil(o.ContainerPort) {
+		toSerialize["containerPort"] = o.ContainerPort
+	}
 	toSerialize["createdAt"] = o.CreatedAt
+	if o.DeniedIps != nil {
+		toSerialize["deniedIps"] = o.DeniedIps
+	}
+	if !IsNil(o.ErrorPages) {
+		toSerialize["errorPages"] = o.ErrorPages
+	}
+	if !IsNil(o.Executable) {
+		toSerialize["executable"] = o.Executable
+	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	if !IsNil(o.ExternalSource) {
 		toSerialize["externalSource"] = o.ExternalSource
 	}
 	if !IsNil(o.ExternalSourceType) {
 		toSerialize["externalSourceType"] = o.ExternalSourceType
 	}
+	if o.HeaderRules != nil {
+		toSerialize["headerRules"] = o.HeaderRules
+	}
+	if !IsNil(o.Headers) {
+		toSerialize["headers"] = o.Headers
+	}
+	if !IsNil(o.HealthCheckInterval) {
+		toSerialize["healthCheckInterval"] = o.HealthCheckInterval
+	}
+	if !IsNil(o.HealthCheckPath) {
+		toSerialize["healthCheckPath"] = o.HealthCheckPath
+	}
+	if !IsNil(o.Image) {
+		toSerialize["image"] = o.Image
+	}
+	if !IsNil(o.LoadBalancing) {
+		toSerialize["loadBalancing"] = o.LoadBalancing
+	}
 	toSerialize["meta"] = o.Meta
-	toSerialize["name"] = o.Name
+	if !IsNil(o.Name) {
+		toSerialize["name"] = o.Name
+	}
 	if !IsNil(o.NoContentYet) {
 		toSerialize["noContentYet"] = o.NoContentYet
 	}
 	if !IsNil(o.PreserveExternalPath) {
 		toSerialize["preserveExternalPath"] = o.PreserveExternalPath
 	}
+	if !IsNil(o.PreviewDomain) {
+		toSerialize["previewDomain"] = o.PreviewDomain
+	}
+	if !IsNil(o.PreviewOf) {
+		toSerialize["previewOf"] = o.PreviewOf
+	}
+	if !IsNil(o.PreviewTtl) {
+		toSerialize["previewTtl"] = o.PreviewTtl
+	}
 	if !IsNil(o.Redirect) {
 		toSerialize["redirect"] = o.Redirect
 	}
+	if o.SecurityHeaders != nil {
+		toSerialize["securityHeaders"] = o.SecurityHeaders
+	}
 	if !IsNil(o.ServerContentLocation) {
 		toSerialize["serverContentLocation"] = o.ServerContentLocation
 	}
+	if !IsNil(o.ShareToken) {
+		toSerialize["shareToken"] = o.ShareToken
+	}
+	toSerialize["shareTokenSet"] = o.ShareTokenSet
 	if !IsNil(o.SpaMode) {
 		toSerialize["spaMode"] = o.SpaMode
 	}
@@ -552,6 +1295,9 @@ func (o DeploymentModel) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["type"] = o.Type
 	toSerialize["updatedAt"] = o.UpdatedAt
+	if o.Upstreams != nil {
+		toSerialize["upstreams"] = o.Upstreams
+	}
 	toSerialize["url"] = o.Url
 	return toSerialize, nil
 }
@@ -563,7 +1309,7 @@ func (o *DeploymentModel) UnmarshalJSON(data []byte) (err error) {
 	requiredProperties := []string{
 		"createdAt",
 		"meta",
-		"name",
+		"shareTokenSet",
 		"type",
 		"updatedAt",
 		"url",
@@ -21,19 +21,43 @@ var _ MappedNullable = &EmptyDeployment{}
 
 // EmptyDeployment struct for EmptyDeployment
 type EmptyDeployment struct {
+	// If there are any of these, only requests from these IP addresses or ranges (in CIDR notation) can get into this deployment.
+	AllowedIps []string `json:"allowedIps,omitempty"`
+	// Users who can get into this deployment with HTTP basic auth. If there are any, the deployment isn't public anymore; visitors need one of these usernames and passwords (or the share token).
+	BasicAuthUsers []BasicAuthUserModel `json:"basicAuthUsers,omitempty"`
 	// When the deployment was created (string in ISO-8601 format.)
 	CreatedAt string `json:"createdAt"`
+	// Requests from these IP addresses or ranges (in CIDR notation) can't get into this deployment.
+	DeniedIps []string `json:"deniedIps,omitempty"`
+	// Pages from a static deployment's files to show when it responds with an error. The keys are status codes like \"404\", or ranges of them like \"5xx\" or \"50x\", and the values are the paths to the pages. More specific status codes take precedence over ranges.
+	ErrorPages map[string]string `json:"errorPages,omitempty"`
+	// If this is a preview deployment, when it will be deleted (string in ISO-8601 format.)
+	ExpiresAt *string `json:"expiresAt,omitempty"`
 	// Original repository for this deployment's source. Can include a branch name.
 	ExternalSource *string `json:"externalSource,omitempty"`
-	// Place where the original repository lives.
+	// Place where the original repository lives. This has to be the name of one of the server's OIDC issuers; \"Github\" always works.
 	ExternalSourceType *string `json:"externalSourceType,omitempty"`
+	// Changes to make to the headers of responses from this deployment, in order. These are applied after the security headers, so they can override them.
+	HeaderRules []HeaderRuleModel `json:"headerRules,omitempty"`
 	Meta SiteMeta `json:"meta"`
 	// Name for the deployment. This is just metadata; make it whatever you want.
-	Name string `json:"name"`
+	Name *string `json:"name,omitempty"`
 	// Set to true to indicate that this deployment has not yet been set up.
 	NoContentYet *bool `json:"noContentYet,omitempty"`
 	// If this is true and the deployment url has a path like \"/thing\", then the \"/thing\" in the path will be transparently passed through to the underlying resource instead of being removed (which is the default)
 	PreserveExternalPath *bool `json:"preserveExternalPath,omitempty"`
+	// If this is set, anyone who can deploy this deployment's repository (including from other branches) can create preview deployments at subdomains of this domain. It has to be the deployment's own domain or a subdomain of it, and no other deployment can be using it.
+	PreviewDomain *string `json:"previewDomain,omitempty"`
+	// If this is a preview deployment, the URL of the deployment that it's a preview of.
+	PreviewOf *string `json:"previewOf,omitempty"`
+	// How long preview deployments last before they are deleted, like \"72h\". Defaults to one week.
+	PreviewTtl *string `json:"previewTtl,omitempty"`
+	// Presets for common security headers to add to responses from this deployment. \"hsts\" sets Strict-Transport-Security, \"csp\" sets a strict Content-Security-Policy that only allows resources from the deployment's own origin, \"frame-options\" sets X-Frame-Options to SAMEORIGIN, \"content-type-options\" sets X-Content-Type-Options to nosniff, and \"referrer-policy\" sets Referrer-Policy to strict-origin-when-cross-origin.
+	SecurityHeaders []string `json:"securityHeaders,omitempty"`
+	// If this is set, the deployment isn't public anymore, but anyone with a link that has this token as its golf_share query parameter can get in. After they visit the link, the token is kept in a cookie. It has to be at least 16 characters long, and can only have letters, numbers, _, and -. It's only included in responses for callers who can modify the deployment.
+	ShareToken *string `json:"shareToken,omitempty"`
+	// Whether the deployment has a share token. The token itself is only included for callers who can modify the deployment.
+	ShareTokenSet bool `json:"shareTokenSet"`
 	// Tags used for metadata.
 	Tags []string `json:"tags,omitempty"`
 	// Type of deployment contents.
@@ -50,11 +74,11 @@ type _EmptyDeployment EmptyDeployment
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewEmptyDeployment(createdAt string, meta SiteMeta, name string, type_ string, updatedAt string, url string) *EmptyDeployment {
+func NewEmptyDeployment(createdAt string, meta SiteMeta, shareTokenSet bool, type_ string, updatedAt string, url string) *EmptyDeployment {
 	this := EmptyDeployment{}
 	this.CreatedAt = createdAt
 	this.Meta = meta
-	this.Name = name
+	this.ShareTokenSet = shareTokenSet
 	this.Type = type_
 	this.UpdatedAt = updatedAt
 	this.Url = url
@@ -69,6 +93,72 @@ func NewEmptyDeploymentWithDefaults() *EmptyDeployment {
 	return &this
 }
 
+// GetAllowedIps returns the AllowedIps field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *EmptyDeployment) GetAllowedIps() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.AllowedIps
+}
+
+// GetAllowedIpsOk returns a tuple with the AllowedIps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *EmptyDeployment) GetAllowedIpsOk() ([]string, bool) {
+	if o == nil || IsNil(o.AllowedIps) {
+		return nil, false
+	}
+	return o.AllowedIps, true
+}
+
+// HasAllowedIps returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasAllowedIps() bool {
+	if o != nil && !IsNil(o.AllowedIps) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowedIps gets a reference to the given []string and assigns it to the AllowedIps field.
+func (o *EmptyDeployment) SetAllowedIps(v []string) {
+	o.AllowedIps = v
+}
+
+// GetBasicAuthUsers returns the BasicAuthUsers field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *EmptyDeployment) GetBasicAuthUsers() []BasicAuthUserModel {
+	if o == nil {
+		var ret []BasicAuthUserModel
+		return ret
+	}
+	return o.BasicAuthUsers
+}
+
+// GetBasicAuthUsersOk returns a tuple with the BasicAuthUsers field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *EmptyDeployment) GetBasicAuthUsersOk() ([]BasicAuthUserModel, bool) {
+	if o == nil || IsNil(o.BasicAuthUsers) {
+		return nil, false
+	}
+	return o.BasicAuthUsers, true
+}
+
+// HasBasicAuthUsers returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasBasicAuthUsers() bool {
+	if o != nil && !IsNil(o.BasicAuthUsers) {
+		return true
+	}
+
+	return false
+}
+
+// SetBasicAuthUsers gets a reference to the given []BasicAuthUserModel and assigns it to the BasicAuthUsers field.
+func (o *EmptyDeployment) SetBasicAuthUsers(v []BasicAuthUserModel) {
+	o.BasicAuthUsers = v
+}
+
 // GetCreatedAt returns the CreatedAt field value
 func (o *EmptyDeployment) GetCreatedAt() string {
 	if o == nil {
@@ -93,6 +183,103 @@ func (o *EmptyDeployment) SetCreatedAt(v string) {
 	o.CreatedAt = v
 }
 
+// GetDeniedIps returns the DeniedIps field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *EmptyDeployment) GetDeniedIps() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.DeniedIps
+}
+
+// GetDeniedIpsOk returns a tuple with the DeniedIps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *EmptyDeployment) GetDeniedIpsOk() ([]string, bool) {
+	if o == nil || IsNil(o.DeniedIps) {
+		return nil, false
+	}
+	return o.DeniedIps, true
+}
+
+// HasDeniedIps returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasDeniedIps() bool {
+	if o != nil && !IsNil(o.DeniedIps) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeniedIps gets a reference to the given []string and assigns it to the DeniedIps field.
+func (o *EmptyDeployment) SetDeniedIps(v []string) {
+	o.DeniedIps = v
+}
+
+// GetErrorPages returns the ErrorPages field value if set, zero value otherwise.
+func (o *EmptyDeployment) GetErrorPages() map[string]string {
+	if o == nil || IsNil(o.ErrorPages) {
+		var ret map[string]string
+		return ret
+	}
+	return o.ErrorPages
+}
+
+// GetErrorPagesOk returns a tuple with the ErrorPages field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EmptyDeployment) GetErrorPagesOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.ErrorPages) {
+		return map[string]string{}, false
+	}
+	return o.ErrorPages, true
+}
+
+// HasErrorPages returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasErrorPages() bool {
+	if o != nil && !IsNil(o.ErrorPages) {
+		return true
+	}
+
+	return false
+}
+
+// SetErrorPages gets a reference to the given map[string]string and assigns it to the ErrorPages field.
+func (o *EmptyDeployment) SetErrorPages(v map[string]string) {
+	o.ErrorPages = v
+}
+
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *EmptyDeployment) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EmptyDeployment) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *EmptyDeployment) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetExternalSource returns the ExternalSource field value if set, zero value otherwise.
 func (o *EmptyDeployment) GetExternalSource() string {
 	if o == nil || IsNil(o.ExternalSource) {
@@ -157,6 +344,39 @@ func (o *EmptyDeployment) SetExternalSourceType(v string) {
 	o.ExternalSourceType = &v
 }
 
+// GetHeaderRules returns the HeaderRules field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *EmptyDeployment) GetHeaderRules() []HeaderRuleModel {
+	if o == nil {
+		var ret []HeaderRuleModel
+		return ret
+	}
+	return o.HeaderRules
+}
+
+// GetHeaderRulesOk returns a tuple with the HeaderRules field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *EmptyDeployment) GetHeaderRulesOk() ([]HeaderRuleModel, bool) {
+	if o == nil || IsNil(o.HeaderRules) {
+		return nil, false
+	}
+	return o.HeaderRules, true
+}
+
+// HasHeaderRules returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasHeaderRules() bool {
+	if o != nil && !IsNil(o.HeaderRules) {
+		return true
+	}
+
+	return false
+}
+
+// SetHeaderRules gets a reference to the given []HeaderRuleModel and assigns it to the HeaderRules field.
+func (o *EmptyDeployment) SetHeaderRules(v []HeaderRuleModel) {
+	o.HeaderRules = v
+}
+
 // GetMeta returns the Meta field value
 func (o *EmptyDeployment) GetMeta() SiteMeta {
 	if o == nil {
@@ -181,28 +401,36 @@ func (o *EmptyDeployment) SetMeta(v SiteMeta) {
 	o.Meta = v
 }
 
-// GetName returns the Name field value
+// GetName returns the Name field value if set, zero value otherwise.
 func (o *EmptyDeployment) GetName() string {
-	if o == nil {
+	if o == nil || IsNil(o.Name) {
 		var ret string
 		return ret
 	}
-
-	return o.Name
+	return *o.Name
 }
 
-// GetNameOk returns a tuple with the Name field value
+// GetNameOk returns a tuple with the Name field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *EmptyDeployment) GetNameOk() (*string, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.Name) {
 		return nil, false
 	}
-	return &o.Name, true
+	return o.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasName() bool {
+	if o != nil && !IsNil(o.Name) {
+		return true
+	}
+
+	return false
 }
 
-// SetName sets field value
+// SetName gets a reference to the given string and assigns it to the Name field.
 func (o *EmptyDeployment) SetName(v string) {
-	o.Name = v
+	o.Name = &v
 }
 
 // GetNoContentYet returns the NoContentYet field value if set, zero value otherwise.
@@ -269,6 +497,191 @@ func (o *EmptyDeployment) SetPreserveExternalPath(v bool) {
 	o.PreserveExternalPath = &v
 }
 
+// GetPreviewDomain returns the PreviewDomain field value if set, zero value otherwise.
+func (o *EmptyDeployment) GetPreviewDomain() string {
+	if o == nil || IsNil(o.PreviewDomain) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewDomain
+}
+
+// GetPreviewDomainOk returns a tuple with the PreviewDomain field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EmptyDeployment) GetPreviewDomainOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewDomain) {
+		return nil, false
+	}
+	return o.PreviewDomain, true
+}
+
+// HasPreviewDomain returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasPreviewDomain() bool {
+	if o != nil && !IsNil(o.PreviewDomain) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewDomain gets a reference to the given string and assigns it to the PreviewDomain field.
+func (o *EmptyDeployment) SetPreviewDomain(v string) {
+	o.PreviewDomain = &v
+}
+
+// GetPreviewOf returns the PreviewOf field value if set, zero value otherwise.
+func (o *EmptyDeployment) GetPreviewOf() string {
+	if o == nil || IsNil(o.PreviewOf) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewOf
+}
+
+// GetPreviewOfOk returns a tuple with the PreviewOf field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EmptyDeployment) GetPreviewOfOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewOf) {
+		return nil, false
+	}
+	return o.PreviewOf, true
+}
+
+// HasPreviewOf returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasPreviewOf() bool {
+	if o != nil && !IsNil(o.PreviewOf) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewOf gets a reference to the given string and assigns it to the PreviewOf field.
+func (o *EmptyDeployment) SetPreviewOf(v string) {
+	o.PreviewOf = &v
+}
+
+// GetPreviewTtl returns the PreviewTtl field value if set, zero value otherwise.
+func (o *EmptyDeployment) GetPreviewTtl() string {
+	if o == nil || IsNil(o.PreviewTtl) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewTtl
+}
+
+// GetPreviewTtlOk returns a tuple with the PreviewTtl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EmptyDeployment) GetPreviewTtlOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewTtl) {
+		return nil, false
+	}
+	return o.PreviewTtl, true
+}
+
+// HasPreviewTtl returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasPreviewTtl() bool {
+	if o != nil && !IsNil(o.PreviewTtl) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewTtl gets a reference to the given string and assigns it to the PreviewTtl field.
+func (o *EmptyDeployment) SetPreviewTtl(v string) {
+	o.PreviewTtl = &v
+}
+
+// GetSecurityHeaders returns the SecurityHeaders field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *EmptyDeployment) GetSecurityHeaders() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.SecurityHeaders
+}
+
+// GetSecurityHeadersOk returns a tuple with the SecurityHeaders field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *EmptyDeployment) GetSecurityHeadersOk() ([]string, bool) {
+	if o == nil || IsNil(o.SecurityHeaders) {
+		return nil, false
+	}
+	return o.SecurityHeaders, true
+}
+
+// HasSecurityHeaders returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasSecurityHeaders() bool {
+	if o != nil && !IsNil(o.SecurityHeaders) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecurityHeaders gets a reference to the given []string and assigns it to the SecurityHeaders field.
+func (o *EmptyDeployment) SetSecurityHeaders(v []string) {
+	o.SecurityHeaders = v
+}
+
+// GetShareToken returns the ShareToken field value if set, zero value otherwise.
+func (o *EmptyDeployment) GetShareToken() string {
+	if o == nil || IsNil(o.ShareToken) {
+		var ret string
+		return ret
+	}
+	return *o.ShareToken
+}
+
+// GetShareTokenOk returns a tuple with the ShareToken field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EmptyDeployment) GetShareTokenOk() (*string, bool) {
+	if o == nil || IsNil(o.ShareToken) {
+		return nil, false
+	}
+	return o.ShareToken, true
+}
+
+// HasShareToken returns a boolean if a field has been set.
+func (o *EmptyDeployment) HasShareToken() bool {
+	if o != nil && !IsNil(o.ShareToken) {
+		return true
+	}
+
+	return false
+}
+
+// SetShareToken gets a reference to the given string and assigns it to the ShareToken field.
+func (o *EmptyDeployment) SetShareToken(v string) {
+	o.ShareToken = &v
+}
+
+// GetShareTokenSet returns the ShareTokenSet field value
+func (o *EmptyDeployment) GetShareTokenSet() bool {
+	if o == nil {
+		var ret bool
+		return ret
+	}
+
+	return o.ShareTokenSet
+}
+
+// GetShareTokenSetOk returns a tuple with the ShareTokenSet field value
+// and a boolean to check if the value has been set.
+func (o *EmptyDeployment) GetShareTokenSetOk() (*bool, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.ShareTokenSet, true
+}
+
+// SetShareTokenSet sets field value
+func (o *EmptyDeployment) SetShareTokenSet(v bool) {
+	o.ShareTokenSet = v
+}
+
 // GetTags returns the Tags field value if set, zero value otherwise (both if not set or set to explicit null).
 func (o *EmptyDeployment) GetTags() []string {
 	if o == nil {
@@ -384,21 +797,57 @@ func (o EmptyDeployment) MarshalJSON() ([]byte, error) {
 
 func (o EmptyDeployment) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if o.AllowedIps != nil {
+		toSerialize["allowedIps"] = o.AllowedIps
+	}
+	if o.BasicAuthUsers != nil {
+		toSerialize["basicAuthUsers"] = o.BasicAuthUsers
+	}
 	toSerialize["createdAt"] = o.CreatedAt
+	if o.DeniedIps != nil {
+		toSerialize["deniedIps"] = o.DeniedIps
+	}
+	if !IsNil(o.ErrorPages) {
+		toSerialize["errorPages"] = o.ErrorPages
+	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	if !IsNil(o.ExternalSource) {
 		toSerialize["externalSource"] = o.ExternalSource
 	}
 	if !IsNil(o.ExternalSourceType) {
 		toSerialize["externalSourceType"] = o.ExternalSourceType
 	}
+	if o.HeaderRules != nil {
+		toSerialize["headerRules"] = o.HeaderRules
+	}
 	toSerialize["meta"] = o.Meta
-	toSerialize["name"] = o.Name
+	if !IsNil(o.Name) {
+		toSerialize["name"] = o.Name
+	}
 	if !IsNil(o.NoContentYet) {
 		toSerialize["noContentYet"] = o.NoContentYet
 	}
 	if !IsNil(o.PreserveExternalPath) {
 		toSerialize["preserveExternalPath"] = o.PreserveExternalPath
 	}
+	if !IsNil(o.PreviewDomain) {
+		toSerialize["previewDomain"] = o.PreviewDomain
+	}
+	if !IsNil(o.PreviewOf) {
+		toSerialize["previewOf"] = o.PreviewOf
+	}
+	if !IsNil(o.PreviewTtl) {
+		toSerialize["previewTtl"] = o.PreviewTtl
+	}
+	if o.SecurityHeaders != nil {
+		toSerialize["securityHeaders"] = o.SecurityHeaders
+	}
+	if !IsNil(o.ShareToken) {
+		toSerialize["shareToken"] = o.ShareToken
+	}
+	toSerialize["shareTokenSet"] = o.ShareTokenSet
 	if o.Tags != nil {
 		toSerialize["tags"] = o.Tags
 	}
@@ -415,7 +864,7 @@ func (o *EmptyDeployment) UnmarshalJSON(data []byte) (err error) {
 	requiredProperties := []string{
 		"createdAt",
 		"meta",
-		"name",
+		"shareTokenSet",
 		"type",
 		"updatedAt",
 		"url",
@@ -19,7 +19,10 @@ import (
 // GetDeployment200Response - struct for GetDeployment200Response
 type GetDeployment200Response struct {
 	AliasDeployment *AliasDeployment
+	ContainerDeployment *ContainerDeployment
 	EmptyDeployment *EmptyDeployment
+	ProcessDeployment *ProcessDeployment
+	ReverseProxyDeployment *ReverseProxyDeployment
 	StaticSiteDeployment *StaticSiteDeployment
 }
 
@@ -30,6 +33,13 @@ func AliasDeploymentAsGetDeployment200Response(v *AliasDeployment) GetDeployment
 	}
 }
 
+// ContainerDeploymentAsGetDeployment200Response is a convenience function that returns ContainerDeployment wrapped in GetDeployment200Response
+func ContainerDeploymentAsGetDeployment200Response(v *ContainerDeployment) GetDeployment200Response {
+	return GetDeployment200Response{
+		ContainerDeployment: v,
+	}
+}
+
 // EmptyDeploymentAsGetDeployment200Response is a convenience function that returns EmptyDeployment wrapped in GetDeployment200Response
 func EmptyDeploymentAsGetDeployment200Response(v *EmptyDeployment) GetDeployment200Response {
 	return GetDeployment200Response{
@@ -37,6 +47,20 @@ func EmptyDeploymentAsGetDeployment200Response(v *EmptyDeployment) GetDeployment
 	}
 }
 
+// ProcessDeploymentAsGetDeployment200Response is a convenience function that returns ProcessDeployment wrapped in GetDeployment200Response
+func ProcessDeploymentAsGetDeployment200Response(v *ProcessDeployment) GetDeployment200Response {
+	return GetDeployment200Response{
+		ProcessDeployment: v,
+	}
+}
+
+// ReverseProxyDeploymentAsGetDeployment200Response is a convenience function that returns ReverseProxyDeployment wrapped in GetDeployment200Response
+func ReverseProxyDeploymentAsGetDeployment200Response(v *ReverseProxyDeployment) GetDeployment200Response {
+	return GetDeployment200Response{
+		ReverseProxyDeployment: v,
+	}
+}
+
 // StaticSiteDeploymentAsGetDeployment200Response is a convenience function that returns StaticSiteDeployment wrapped in GetDeployment200Response
 func StaticSiteDeploymentAsGetDeployment200Response(v *StaticSiteDeployment) GetDeployment200Response {
 	return GetDeployment200Response{
@@ -66,6 +90,23 @@ func (dst *GetDeployment200Response) UnmarshalJSON(data []byte) error {
 		dst.AliasDeployment = nil
 	}
 
+	// try to unmarshal data into ContainerDeployment
+	err = newStrictDecoder(data).Decode(&dst.ContainerDeployment)
+	if err == nil {
+		jsonContainerDeployment, _ := json.Marshal(dst.ContainerDeployment)
+		if string(jsonContainerDeployment) == "{}" { // empty struct
+			dst.ContainerDeployment = nil
+		} else {
+			if err = validator.Validate(dst.ContainerDeployment); err != nil {
+				dst.ContainerDeployment = nil
+			} else {
+				match++
+			}
+		}
+	} else {
+		dst.ContainerDeployment = nil
+	}
+
 	// try to unmarshal data into EmptyDeployment
 	err = newStrictDecoder(data).Decode(&dst.EmptyDeployment)
 	if err == nil {
@@ -83,6 +124,40 @@ func (dst *GetDeployment200Response) UnmarshalJSON(data []byte) error {
 		dst.EmptyDeployment = nil
 	}
 
+	// try to unmarshal data into ProcessDeployment
+	err = newStrictDecoder(data).Decode(&dst.ProcessDeployment)
+	if err == nil {
+		jsonProcessDeployment, _ := json.Marshal(dst.ProcessDeployment)
+		if string(jsonProcessDeployment) == "{}" { // empty struct
+			dst.ProcessDeployment = nil
+		} else {
+			if err = validator.Validate(dst.ProcessDeployment); err != nil {
+				dst.ProcessDeployment = nil
+			} else {
+				match++
+			}
+		}
+	} else {
+		dst.ProcessDeployment = nil
+	}
+
+	// try to unmarshal data into ReverseProxyDeployment
+	err = newStrictDecoder(data).Decode(&dst.ReverseProxyDeployment)
+	if err == nil {
+		jsonReverseProxyDeployment, _ := json.Marshal(dst.ReverseProxyDeployment)
+		if string(jsonReverseProxyDeployment) == "{}" { // empty struct
+			dst.ReverseProxyDeployment = nil
+		} else {
+			if err = validator.Validate(dst.ReverseProxyDeployment); err != nil {
+				dst.ReverseProxyDeployment = nil
+			} else {
+				match++
+			}
+		}
+	} else {
+		dst.ReverseProxyDeployment = nil
+	}
+
 	// try to unmarshal data into StaticSiteDeployment
 	err = newStrictDecoder(data).Decode(&dst.StaticSiteDeployment)
 	if err == nil {
@@ -103,7 +178,10 @@ func (dst *GetDeployment200Response) UnmarshalJSON(data []byte) error {
 	if match > 1 { // more than 1 match
 		// reset to nil
 		dst.AliasDeployment = nil
+		dst.ContainerDeployment = nil
 		dst.EmptyDeployment = nil
+		dst.ProcessDeployment = nil
+		dst.ReverseProxyDeployment = nil
 		dst.StaticSiteDeployment = nil
 
 		return fmt.Errorf("data matches more than one schema in oneOf(GetDeployment200Response)")
@@ -120,10 +198,22 @@ func (src GetDeployment200Response) MarshalJSON() ([]byte, error) {
 		return json.Marshal(&src.AliasDeployment)
 	}
 
+	if src.ContainerDeployment != nil {
+		return json.Marshal(&src.ContainerDeployment)
+	}
+
 	if src.EmptyDeployment != nil {
 		return json.Marshal(&src.EmptyDeployment)
 	}
 
+	if src.ProcessDeployment != nil {
+		return json.Marshal(&src.ProcessDeployment)
+	}
+
+	if src.ReverseProxyDeployment != nil {
+		return json.Marshal(&src.ReverseProxyDeployment)
+	}
+
 	if src.StaticSiteDeployment != nil {
 		return json.Marshal(&src.StaticSiteDeployment)
 	}
@@ -140,10 +230,22 @@ func (obj *GetDeployment200Response) GetActualInstance() (interface{}) {
 		return obj.AliasDeployment
 	}
 
+	if obj.ContainerDeployment != nil {
+		return obj.ContainerDeployment
+	}
+
 	if obj.EmptyDeployment != nil {
 		return obj.EmptyDeployment
 	}
 
+	if obj.ProcessDeployment != nil {
+		return obj.ProcessDeployment
+	}
+
+	if obj.ReverseProxyDeployment != nil {
+		return obj.ReverseProxyDeployment
+	}
+
 	if obj.StaticSiteDeployment != nil {
 		return obj.StaticSiteDeployment
 	}
@@ -158,10 +260,22 @@ func (obj GetDeployment200Response) GetActualInstanceValue() (interface{}) {
 		return *obj.AliasDeployment
 	}
 
+	if obj.ContainerDeployment != nil {
+		return *obj.ContainerDeployment
+	}
+
 	if obj.EmptyDeployment != nil {
 		return *obj.EmptyDeployment
 	}
 
+	if obj.ProcessDeployment != nil {
+		return *obj.ProcessDeployment
+	}
+
+	if obj.ReverseProxyDeployment != nil {
+		return *obj.ReverseProxyDeployment
+	}
+
 	if obj.StaticSiteDeployment != nil {
 		return *obj.StaticSiteDeployment
 	}
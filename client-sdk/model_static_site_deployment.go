@@ -21,19 +21,43 @@ var _ MappedNullable = &StaticSiteDeployment{}
 
 // StaticSiteDeployment struct for StaticSiteDeployment
 type StaticSiteDeployment struct {
+	// If there are any of these, only requests from these IP addresses or ranges (in CIDR notation) can get into this deployment.
+	AllowedIps []string `json:"allowedIps,omitempty"`
+	// Users who can get into this deployment with HTTP basic auth. If there are any, the deployment isn't public anymore; visitors need one of these usernames and passwords (or the share token).
+	BasicAuthUsers []BasicAuthUserModel `json:"basicAuthUsers,omitempty"`
 	// When the deployment was created (string in ISO-8601 format.)
 	CreatedAt string `json:"createdAt"`
+	// Requests from these IP addresses or ranges (in CIDR notation) can't get into this deployment.
+	DeniedIps []string `json:"deniedIps,omitempty"`
+	// Pages from a static deployment's files to show when it responds with an error. The keys are status codes like \"404\", or ranges of them like \"5xx\" or \"50x\", and the values are the paths to the pages. More specific status codes take precedence over ranges.
+	ErrorPages map[string]string `json:"errorPages,omitempty"`
+	// If this is a preview deployment, when it will be deleted (string in ISO-8601 format.)
+	ExpiresAt *string `json:"expiresAt,omitempty"`
 	// Original repository for this deployment's source. Can include a branch name.
 	ExternalSource *string `json:"externalSource,omitempty"`
-	// Place where the original repository lives.
+	// Place where the original repository lives. This has to be the name of one of the server's OIDC issuers; \"Github\" always works.
 	ExternalSourceType *string `json:"externalSourceType,omitempty"`
+	// Changes to make to the headers of responses from this deployment, in order. These are applied after the security headers, so they can override them.
+	HeaderRules []HeaderRuleModel `json:"headerRules,omitempty"`
 	Meta SiteMeta `json:"meta"`
 	// Name for the deployment. This is just metadata; make it whatever you want.
-	Name string `json:"name"`
+	Name *string `json:"name,omitempty"`
 	// If this is true and the deployment url has a path like \"/thing\", then the \"/thing\" in the path will be transparently passed through to the underlying resource instead of being removed (which is the default)
 	PreserveExternalPath *bool `json:"preserveExternalPath,omitempty"`
+	// If this is set, anyone who can deploy this deployment's repository (including from other branches) can create preview deployments at subdomains of this domain. It has to be the deployment's own domain or a subdomain of it, and no other deployment can be using it.
+	PreviewDomain *string `json:"previewDomain,omitempty"`
+	// If this is a preview deployment, the URL of the deployment that it's a preview of.
+	PreviewOf *string `json:"previewOf,omitempty"`
+	// How long preview deployments last before they are deleted, like \"72h\". Defaults to one week.
+	PreviewTtl *string `json:"previewTtl,omitempty"`
+	// Presets for common security headers to add to responses from this deployment. \"hsts\" sets Strict-Transport-Security, \"csp\" sets a strict Content-Security-Policy that only allows resources from the deployment's own origin, \"frame-options\" sets X-Frame-Options to SAMEORIGIN, \"content-type-options\" sets X-Content-Type-Options to nosniff, and \"referrer-policy\" sets Referrer-Policy to strict-origin-when-cross-origin.
+	SecurityHeaders []string `json:"securityHeaders,omitempty"`
 	// The path to this deployment's files on the server.
 	ServerContentLocation *string `json:"serverContentLocation,omitempty"`
+	// If this is set, the deployment isn't public anymore, but anyone with a link that has this token as its golf_share query parameter can get in. After they visit the link, the token is kept in a cookie. It has to be at least 16 characters long, and can only have letters, numbers, _, and -. It's only included in responses for callers who can modify the deployment.
+	ShareToken *string `json:"shareToken,omitempty"`
+	// Whether the deployment has a share token. The token itself is only included for callers who can modify the deployment.
+	ShareTokenSet bool `json:"shareTokenSet"`
 	// Whether this deployment is set up to support a Single Page App by using /index.html as a fallback for all requests.
 	SpaMode *bool `json:"spaMode,omitempty"`
 	// Tags used for metadata.
@@ -52,11 +76,11 @@ type _StaticSiteDeployment StaticSiteDeployment
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewStaticSiteDeployment(createdAt string, meta SiteMeta, name string, type_ string, updatedAt string, url string) *StaticSiteDeployment {
+func NewStaticSiteDeployment(createdAt string, meta SiteMeta, shareTokenSet bool, type_ string, updatedAt string, url string) *StaticSiteDeployment {
 	this := StaticSiteDeployment{}
 	this.CreatedAt = createdAt
 	this.Meta = meta
-	this.Name = name
+	this.ShareTokenSet = shareTokenSet
 	this.Type = type_
 	this.UpdatedAt = updatedAt
 	this.Url = url
@@ -71,6 +95,72 @@ func NewStaticSiteDeploymentWithDefaults() *StaticSiteDeployment {
 	return &this
 }
 
+// GetAllowedIps returns the AllowedIps field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *StaticSiteDeployment) GetAllowedIps() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.AllowedIps
+}
+
+// GetAllowedIpsOk returns a tuple with the AllowedIps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *StaticSiteDeployment) GetAllowedIpsOk() ([]string, bool) {
+	if o == nil || IsNil(o.AllowedIps) {
+		return nil, false
+	}
+	return o.AllowedIps, true
+}
+
+// HasAllowedIps returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasAllowedIps() bool {
+	if o != nil && !IsNil(o.AllowedIps) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowedIps gets a reference to the given []string and assigns it to the AllowedIps field.
+func (o *StaticSiteDeployment) SetAllowedIps(v []string) {
+	o.AllowedIps = v
+}
+
+// GetBasicAuthUsers returns the BasicAuthUsers field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *StaticSiteDeployment) GetBasicAuthUsers() []BasicAuthUserModel {
+	if o == nil {
+		var ret []BasicAuthUserModel
+		return ret
+	}
+	return o.BasicAuthUsers
+}
+
+// GetBasicAuthUsersOk returns a tuple with the BasicAuthUsers field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *StaticSiteDeployment) GetBasicAuthUsersOk() ([]BasicAuthUserModel, bool) {
+	if o == nil || IsNil(o.BasicAuthUsers) {
+		return nil, false
+	}
+	return o.BasicAuthUsers, true
+}
+
+// HasBasicAuthUsers returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasBasicAuthUsers() bool {
+	if o != nil && !IsNil(o.BasicAuthUsers) {
+		return true
+	}
+
+	return false
+}
+
+// SetBasicAuthUsers gets a reference to the given []BasicAuthUserModel and assigns it to the BasicAuthUsers field.
+func (o *StaticSiteDeployment) SetBasicAuthUsers(v []BasicAuthUserModel) {
+	o.BasicAuthUsers = v
+}
+
 // GetCreatedAt returns the CreatedAt field value
 func (o *StaticSiteDeployment) GetCreatedAt() string {
 	if o == nil {
@@ -95,6 +185,103 @@ func (o *StaticSiteDeployment) SetCreatedAt(v string) {
 	o.CreatedAt = v
 }
 
+// GetDeniedIps returns the DeniedIps field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *StaticSiteDeployment) GetDeniedIps() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.DeniedIps
+}
+
+// GetDeniedIpsOk returns a tuple with the DeniedIps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *StaticSiteDeployment) GetDeniedIpsOk() ([]string, bool) {
+	if o == nil || IsNil(o.DeniedIps) {
+		return nil, false
+	}
+	return o.DeniedIps, true
+}
+
+// HasDeniedIps returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasDeniedIps() bool {
+	if o != nil && !IsNil(o.DeniedIps) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeniedIps gets a reference to the given []string and assigns it to the DeniedIps field.
+func (o *StaticSiteDeployment) SetDeniedIps(v []string) {
+	o.DeniedIps = v
+}
+
+// GetErrorPages returns the ErrorPages field value if set, zero value otherwise.
+func (o *StaticSiteDeployment) GetErrorPages() map[string]string {
+	if o == nil || IsNil(o.ErrorPages) {
+		var ret map[string]string
+		return ret
+	}
+	return o.ErrorPages
+}
+
+// GetErrorPagesOk returns a tuple with the ErrorPages field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *StaticSiteDeployment) GetErrorPagesOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.ErrorPages) {
+		return map[string]string{}, false
+	}
+	return o.ErrorPages, true
+}
+
+// HasErrorPages returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasErrorPages() bool {
+	if o != nil && !IsNil(o.ErrorPages) {
+		return true
+	}
+
+	return false
+}
+
+// SetErrorPages gets a reference to the given map[string]string and assigns it to the ErrorPages field.
+func (o *StaticSiteDeployment) SetErrorPages(v map[string]string) {
+	o.ErrorPages = v
+}
+
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *StaticSiteDeployment) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *StaticSiteDeployment) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *StaticSiteDeployment) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetExternalSource returns the ExternalSource field value if set, zero value otherwise.
 func (o *StaticSiteDeployment) GetExternalSource() string {
 	if o == nil || IsNil(o.ExternalSource) {
@@ -159,6 +346,39 @@ func (o *StaticSiteDeployment) SetExternalSourceType(v string) {
 	o.ExternalSourceType = &v
 }
 
+// GetHeaderRules returns the HeaderRules field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *StaticSiteDeployment) GetHeaderRules() []HeaderRuleModel {
+	if o == nil {
+		var ret []HeaderRuleModel
+		return ret
+	}
+	return o.HeaderRules
+}
+
+// GetHeaderRulesOk returns a tuple with the HeaderRules field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *StaticSiteDeployment) GetHeaderRulesOk() ([]HeaderRuleModel, bool) {
+	if o == nil || IsNil(o.HeaderRules) {
+		return nil, false
+	}
+	return o.HeaderRules, true
+}
+
+// HasHeaderRules returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasHeaderRules() bool {
+	if o != nil && !IsNil(o.HeaderRules) {
+		return true
+	}
+
+	return false
+}
+
+// SetHeaderRules gets a reference to the given []HeaderRuleModel and assigns it to the HeaderRules field.
+func (o *StaticSiteDeployment) SetHeaderRules(v []HeaderRuleModel) {
+	o.HeaderRules = v
+}
+
 // GetMeta returns the Meta field value
 func (o *StaticSiteDeployment) GetMeta() SiteMeta {
 	if o == nil {
@@ -183,28 +403,36 @@ func (o *StaticSiteDeployment) SetMeta(v SiteMeta) {
 	o.Meta = v
 }
 
-// GetName returns the Name field value
+// GetName returns the Name field value if set, zero value otherwise.
 func (o *StaticSiteDeployment) GetName() string {
-	if o == nil {
+	if o == nil || IsNil(o.Name) {
 		var ret string
 		return ret
 	}
-
-	return o.Name
+	return *o.Name
 }
 
-// GetNameOk returns a tuple with the Name field value
+// GetNameOk returns a tuple with the Name field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *StaticSiteDeployment) GetNameOk() (*string, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.Name) {
 		return nil, false
 	}
-	return &o.Name, true
+	return o.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasName() bool {
+	if o != nil && !IsNil(o.Name) {
+		return true
+	}
+
+	return false
 }
 
-// SetName sets field value
+// SetName gets a reference to the given string and assigns it to the Name field.
 func (o *StaticSiteDeployment) SetName(v string) {
-	o.Name = v
+	o.Name = &v
 }
 
 // GetPreserveExternalPath returns the PreserveExternalPath field value if set, zero value otherwise.
@@ -239,6 +467,135 @@ func (o *StaticSiteDeployment) SetPreserveExternalPath(v bool) {
 	o.PreserveExternalPath = &v
 }
 
+// GetPreviewDomain returns the PreviewDomain field value if set, zero value otherwise.
+func (o *StaticSiteDeployment) GetPreviewDomain() string {
+	if o == nil || IsNil(o.PreviewDomain) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewDomain
+}
+
+// GetPreviewDomainOk returns a tuple with the PreviewDomain field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *StaticSiteDeployment) GetPreviewDomainOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewDomain) {
+		return nil, false
+	}
+	return o.PreviewDomain, true
+}
+
+// HasPreviewDomain returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasPreviewDomain() bool {
+	if o != nil && !IsNil(o.PreviewDomain) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewDomain gets a reference to the given string and assigns it to the PreviewDomain field.
+func (o *StaticSiteDeployment) SetPreviewDomain(v string) {
+	o.PreviewDomain = &v
+}
+
+// GetPreviewOf returns the PreviewOf field value if set, zero value otherwise.
+func (o *StaticSiteDeployment) GetPreviewOf() string {
+	if o == nil || IsNil(o.PreviewOf) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewOf
+}
+
+// GetPreviewOfOk returns a tuple with the PreviewOf field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *StaticSiteDeployment) GetPreviewOfOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewOf) {
+		return nil, false
+	}
+	return o.PreviewOf, true
+}
+
+// HasPreviewOf returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasPreviewOf() bool {
+	if o != nil && !IsNil(o.PreviewOf) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewOf gets a reference to the given string and assigns it to the PreviewOf field.
+func (o *StaticSiteDeployment) SetPreviewOf(v string) {
+	o.PreviewOf = &v
+}
+
+// GetPreviewTtl returns the PreviewTtl field value if set, zero value otherwise.
+func (o *StaticSiteDeployment) GetPreviewTtl() string {
+	if o == nil || IsNil(o.PreviewTtl) {
+		var ret string
+		return ret
+	}
+	return *o.PreviewTtl
+}
+
+// GetPreviewTtlOk returns a tuple with the PreviewTtl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *StaticSiteDeployment) GetPreviewTtlOk() (*string, bool) {
+	if o == nil || IsNil(o.PreviewTtl) {
+		return nil, false
+	}
+	return o.PreviewTtl, true
+}
+
+// HasPreviewTtl returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasPreviewTtl() bool {
+	if o != nil && !IsNil(o.PreviewTtl) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewTtl gets a reference to the given string and assigns it to the PreviewTtl field.
+func (o *StaticSiteDeployment) SetPreviewTtl(v string) {
+	o.PreviewTtl = &v
+}
+
+// GetSecurityHeaders returns the SecurityHeaders field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *StaticSiteDeployment) GetSecurityHeaders() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.SecurityHeaders
+}
+
+// GetSecurityHeadersOk returns a tuple with the SecurityHeaders field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *StaticSiteDeployment) GetSecurityHeadersOk() ([]string, bool) {
+	if o == nil || IsNil(o.SecurityHeaders) {
+		return nil, false
+	}
+	return o.SecurityHeaders, true
+}
+
+// HasSecurityHeaders returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasSecurityHeaders() bool {
+	if o != nil && !IsNil(o.SecurityHeaders) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecurityHeaders gets a reference to the given []string and assigns it to the SecurityHeaders field.
+func (o *StaticSiteDeployment) SetSecurityHeaders(v []string) {
+	o.SecurityHeaders = v
+}
+
 // GetServerContentLocation returns the ServerContentLocation field value if set, zero value otherwise.
 func (o *StaticSiteDeployment) GetServerContentLocation() string {
 	if o == nil || IsNil(o.ServerContentLocation) {
@@ -271,6 +628,62 @@ func (o *StaticSiteDeployment) SetServerContentLocation(v string) {
 	o.ServerContentLocation = &v
 }
 
+// GetShareToken returns the ShareToken field value if set, zero value otherwise.
+func (o *StaticSiteDeployment) GetShareToken() string {
+	if o == nil || IsNil(o.ShareToken) {
+		var ret string
+		return ret
+	}
+	return *o.ShareToken
+}
+
+// GetShareTokenOk returns a tuple with the ShareToken field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *StaticSiteDeployment) GetShareTokenOk() (*string, bool) {
+	if o == nil || IsNil(o.ShareToken) {
+		return nil, false
+	}
+	return o.ShareToken, true
+}
+
+// HasShareToken returns a boolean if a field has been set.
+func (o *StaticSiteDeployment) HasShareToken() bool {
+	if o != nil && !IsNil(o.ShareToken) {
+		return true
+	}
+
+	return false
+}
+
+// SetShareToken gets a reference to the given string and assigns it to the ShareToken field.
+func (o *StaticSiteDeployment) SetShareToken(v string) {
+	o.ShareToken = &v
+}
+
+// GetShareTokenSet returns the ShareTokenSet field value
+func (o *StaticSiteDeployment) GetShareTokenSet() bool {
+	if o == nil {
+		var ret bool
+		return ret
+	}
+
+	return o.ShareTokenSet
+}
+
+// GetShareTokenSetOk returns a tuple with the ShareTokenSet field value
+// and a boolean to check if the value has been set.
+func (o *StaticSiteDeployment) GetShareTokenSetOk() (*bool, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.ShareTokenSet, true
+}
+
+// SetShareTokenSet sets field value
+func (o *StaticSiteDeployment) SetShareTokenSet(v bool) {
+	o.ShareTokenSet = v
+}
+
 // GetSpaMode returns the SpaMode field value if set, zero value otherwise.
 func (o *StaticSiteDeployment) GetSpaMode() bool {
 	if o == nil || IsNil(o.SpaMode) {
@@ -418,21 +831,57 @@ func (o StaticSiteDeployment) MarshalJSON() ([]byte, error) {
 
 func (o StaticSiteDeployment) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if o.AllowedIps != nil {
+		toSerialize["allowedIps"] = o.AllowedIps
+	}
+	if o.BasicAuthUsers != nil {
+		toSerialize["basicAuthUsers"] = o.BasicAuthUsers
+	}
 	toSerialize["createdAt"] = o.CreatedAt
+	if o.DeniedIps != nil {
+		toSerialize["deniedIps"] = o.DeniedIps
+	}
+	if !IsNil(o.ErrorPages) {
+		toSerialize["errorPages"] = o.ErrorPages
+	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	if !IsNil(o.ExternalSource) {
 		toSerialize["externalSource"] = o.ExternalSource
 	}
 	if !IsNil(o.ExternalSourceType) {
 		toSerialize["externalSourceType"] = o.ExternalSourceType
 	}
+	if o.HeaderRules != nil {
+		toSerialize["headerRules"] = o.HeaderRules
+	}
 	toSerialize["meta"] = o.Meta
-	toSerialize["name"] = o.Name
+	if !IsNil(o.Name) {
+		toSerialize["name"] = o.Name
+	}
 	if !IsNil(o.PreserveExternalPath) {
 		toSerialize["preserveExternalPath"] = o.PreserveExternalPath
 	}
+	if !IsNil(o.PreviewDomain) {
+		toSerialize["previewDomain"] = o.PreviewDomain
+	}
+	if !IsNil(o.PreviewOf) {
+		toSerialize["previewOf"] = o.PreviewOf
+	}
+	if !IsNil(o.PreviewTtl) {
+		toSerialize["previewTtl"] = o.PreviewTtl
+	}
+	if o.SecurityHeaders != nil {
+		toSerialize["securityHeaders"] = o.SecurityHeaders
+	}
 	if !IsNil(o.ServerContentLocation) {
 		toSerialize["serverContentLocation"] = o.ServerContentLocation
 	}
+	if !IsNil(o.ShareToken) {
+		toSerialize["shareToken"] = o.ShareToken
+	}
+	toSerialize["shareTokenSet"] = o.ShareTokenSet
 	if !IsNil(o.SpaMode) {
 		toSerialize["spaMode"] = o.SpaMode
 	}
@@ -452,7 +901,7 @@ func (o *StaticSiteDeployment) UnmarshalJSON(data []byte) (err error) {
 	requiredProperties := []string{
 		"createdAt",
 		"meta",
-		"name",
+		"shareTokenSet",
 		"type",
 		"updatedAt",
 		"url",
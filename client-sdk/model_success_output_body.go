@@ -25,6 +25,8 @@ type SuccessOutputBody struct {
 	Schema *string `json:"$schema,omitempty"`
 	Message string `json:"message"`
 	Success bool `json:"success"`
+	// Problems that didn't stop the request from succeeding, like lines in a _redirects file that couldn't be used.
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 type _SuccessOutputBody SuccessOutputBody
@@ -128,6 +130,39 @@ func (o *SuccessOutputBody) SetSuccess(v bool) {
 	o.Success = v
 }
 
+// GetWarnings returns the Warnings field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *SuccessOutputBody) GetWarnings() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.Warnings
+}
+
+// GetWarningsOk returns a tuple with the Warnings field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *SuccessOutputBody) GetWarningsOk() ([]string, bool) {
+	if o == nil || IsNil(o.Warnings) {
+		return nil, false
+	}
+	return o.Warnings, true
+}
+
+// HasWarnings returns a boolean if a field has been set.
+func (o *SuccessOutputBody) HasWarnings() bool {
+	if o != nil && !IsNil(o.Warnings) {
+		return true
+	}
+
+	return false
+}
+
+// SetWarnings gets a reference to the given []string and assigns it to the Warnings field.
+func (o *SuccessOutputBody) SetWarnings(v []string) {
+	o.Warnings = v
+}
+
 func (o SuccessOutputBody) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -143,6 +178,9 @@ func (o SuccessOutputBody) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["message"] = o.Message
 	toSerialize["success"] = o.Success
+	if o.Warnings != nil {
+		toSerialize["warnings"] = o.Warnings
+	}
 	return toSerialize, nil
 }
 
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/internet-golf/internet-golf/pkg/api"
 	database "github.com/internet-golf/internet-golf/pkg/db"
@@ -18,6 +19,22 @@ func main() {
 	var adminApiUrl string
 	var dataDirectory string
 	var verbose bool
+	var dockerHost string
+	var revisionsToKeep int
+	var gcInterval time.Duration
+	var gcGracePeriod time.Duration
+	var maxExtractedSize int64
+	var maxArchiveEntries int
+	var maxUploadSize int64
+	var defaultPage string
+	var oidcIssuersFile string
+	var oidcKeysRefreshInterval time.Duration
+	var oidcKeysFallback time.Duration
+	var sessionLifetime time.Duration
+	var githubOAuthClientId string
+	var localTrust string
+	var trustedNetworks []string
+	var adminApiSocket string
 
 	var rootCmd = &cobra.Command{
 		Use:   "golf-server",
@@ -29,7 +46,37 @@ func main() {
 		// re-implementation of it in utils_test.go
 		Run: func(cmd *cobra.Command, args []string) {
 
-			config := utils.NewConfig(dataDirectory, localOnly, verbose, adminApiPort)
+			config := utils.NewConfig(
+				dataDirectory, localOnly, verbose, adminApiPort, revisionsToKeep,
+				gcInterval, gcGracePeriod,
+			)
+			config.MaxExtractedSize = maxExtractedSize
+			config.MaxArchiveEntries = maxArchiveEntries
+			config.MaxUploadSize = maxUploadSize
+			config.DefaultPage = defaultPage
+			if len(oidcIssuersFile) > 0 {
+				issuers, err := utils.LoadOidcIssuers(oidcIssuersFile)
+				if err != nil {
+					panic(err)
+				}
+				config.OidcIssuers = issuers
+			}
+			config.OidcKeysRefreshInterval = oidcKeysRefreshInterval
+			config.OidcKeysFallback = oidcKeysFallback
+			config.AdminApiPath = adminApiUrl
+			config.SessionLifetime = sessionLifetime
+			config.GithubOAuthClientId = githubOAuthClientId
+			localTrustMode, err := utils.ParseLocalTrustMode(localTrust)
+			if err != nil {
+				panic(err)
+			}
+			config.LocalTrust = localTrustMode
+			networks, err := utils.ParseTrustedNetworks(trustedNetworks)
+			if err != nil {
+				panic(err)
+			}
+			config.TrustedNetworks = networks
+			config.AdminApiSocket = adminApiSocket
 
 			fileManager := resources.NewFileManager(config)
 
@@ -37,13 +84,22 @@ func main() {
 			if err != nil {
 				panic(err)
 			}
+			defer db.Close()
 
 			deploymentServer, err := public.NewPublicWebServer(config, fileManager)
 			if err != nil {
 				panic(err)
 			}
 
-			deploymentBus, err := api.NewDeploymentBus(deploymentServer, db, fileManager)
+			containerManager, err := resources.NewContainerManager(dockerHost)
+			if err != nil {
+				panic(err)
+			}
+
+			deploymentBus, err := api.NewDeploymentBus(
+				config, deploymentServer, db, fileManager, containerManager,
+				resources.NewProcessManager(),
+			)
 			if err != nil {
 				panic(err)
 			}
@@ -54,21 +110,28 @@ func main() {
 			// that's fine as long as the health check endpoint is used)
 			adminApiUrl := database.Url{Path: adminApiUrl}
 
-			deploymentBus.SetupDeployment(
+			if err := deploymentBus.SetupDeployment(
 				database.DeploymentMetadata{
 					Url:         adminApiUrl,
 					DontPersist: true,
 					Internal:    true,
-				})
-			deploymentBus.PutDeploymentContentByUrl(
+				}); err != nil {
+				panic(err)
+			}
+			if err := deploymentBus.PutDeploymentContentByUrl(
 				adminApiUrl,
 				database.DeploymentContent{
 					ServedThingType: database.ReverseProxy,
 					ServedThing:     "127.0.0.1:" + adminApiPort,
-				})
+				}); err != nil {
+				panic(err)
+			}
 
 			// start the admin api
 			server := adminApi.CreateServer()
+			if err := adminApi.ServeSocket(server); err != nil {
+				panic(err)
+			}
 			server.ListenAndServe()
 		},
 	}
@@ -89,7 +152,7 @@ func main() {
 		"Run in local-only mode, so that deployments are only available at localhost:80.",
 	)
 	rootCmd.Flags().StringVar(
-		&adminApiUrl, "admin-api-path", "/_golf",
+		&adminApiUrl, "admin-api-path", utils.DefaultAdminApiPath,
 		"Path prefix for the Admin API endpoints.",
 	)
 	rootCmd.Flags().StringVar(
@@ -100,6 +163,79 @@ func main() {
 		&verbose, "verbose", "v", false,
 		"Output all internal logs",
 	)
+	rootCmd.Flags().IntVar(
+		&revisionsToKeep, "revisions-to-keep", 10,
+		"How many previous versions of each deployment's content to keep around for rollbacks.\n"+
+			"Set to 0 to keep all of them.",
+	)
+	rootCmd.Flags().DurationVar(
+		&gcInterval, "gc-interval", time.Hour,
+		"How often to delete deployment content that isn't used anymore. Set to 0 to turn this off.",
+	)
+	rootCmd.Flags().DurationVar(
+		&gcGracePeriod, "gc-grace-period", 10*time.Minute,
+		"How long deployment content has to be unused before it can be deleted.",
+	)
+	rootCmd.Flags().Int64Var(
+		&maxExtractedSize, "max-extracted-size", utils.DefaultMaxExtractedSize,
+		"Maximum total size, in bytes, of the files in an uploaded archive. Set to 0 for no limit.",
+	)
+	rootCmd.Flags().IntVar(
+		&maxArchiveEntries, "max-archive-entries", utils.DefaultMaxArchiveEntries,
+		"Maximum number of files and directories in an uploaded archive. Set to 0 for no limit.",
+	)
+	rootCmd.Flags().Int64Var(
+		&maxUploadSize, "max-upload-size", utils.DefaultMaxUploadSize,
+		"Maximum size, in bytes, of an uploaded archive or executable. Set to 0 for no limit.",
+	)
+	rootCmd.Flags().StringVar(
+		&defaultPage, "default-page", "",
+		"Path to an HTML file to show for requests that don't match any deployment.",
+	)
+	rootCmd.Flags().StringVar(
+		&oidcIssuersFile, "oidc-issuers", "",
+		"Path to a JSON file with a list of OIDC issuers (like GitLab CI or Forgejo Actions) whose tokens\n"+
+			"should be accepted. Github Actions is always included unless the file has an issuer named \"Github\".",
+	)
+	rootCmd.Flags().DurationVar(
+		&oidcKeysRefreshInterval, "oidc-keys-refresh-interval", utils.DefaultOidcKeysRefreshInterval,
+		"How often to fetch the signing keys of OIDC issuers again.",
+	)
+	rootCmd.Flags().DurationVar(
+		&oidcKeysFallback, "oidc-keys-fallback", utils.DefaultOidcKeysFallback,
+		"How long to keep using an OIDC issuer's old signing keys if they can't be fetched again.\n"+
+			"Set to 0 to reject OIDC tokens whenever the keys can't be refreshed.",
+	)
+	rootCmd.Flags().DurationVar(
+		&sessionLifetime, "session-lifetime", utils.DefaultSessionLifetime,
+		"How long people stay logged in to the admin dashboard.",
+	)
+	rootCmd.Flags().StringVar(
+		&githubOAuthClientId, "github-oauth-client-id", "",
+		"Client ID of a Github OAuth app with the device flow enabled, so that registered Github users\n"+
+			"can log in to the admin dashboard with Github instead of a token.",
+	)
+	rootCmd.Flags().StringVar(
+		&localTrust, "local-trust", string(utils.LocalTrustFull),
+		"What requests from trusted networks and the admin API socket can do without credentials:\n"+
+			"\"full\" lets them do anything, \"bootstrap\" only lets them create the first token,\n"+
+			"and \"off\" doesn't trust them at all.",
+	)
+	rootCmd.Flags().StringSliceVar(
+		&trustedNetworks, "trusted-networks", []string{"127.0.0.1/32", "::1/128"},
+		"Networks (in CIDR notation) whose requests to the admin API are trusted, according to --local-trust.\n"+
+			"Only add networks that nobody untrusted can send requests from.",
+	)
+	rootCmd.Flags().StringVar(
+		&adminApiSocket, "admin-api-socket", "",
+		"Path to a Unix socket to also serve the admin API on. Requests that come in over it are trusted,\n"+
+			"according to --local-trust, so make sure that only trusted users can get to it.",
+	)
+	rootCmd.Flags().StringVar(
+		&dockerHost, "docker-host", "",
+		"Address of the Docker daemon used for container deployments.\n"+
+			"Defaults to the DOCKER_HOST environment variable or the standard Docker socket.",
+	)
 
 	var openapiOutputPath string
 
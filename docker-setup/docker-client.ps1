@@ -1 +1 @@
-docker compose run --rm --name golf-client client ../golf --api-url http://golf-server:8888 $args
+docker compose run --rm client ../golf --api-url unix:///run/golf/admin.sock $args
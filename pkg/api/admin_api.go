@@ -2,17 +2,24 @@ package api
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/netip"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/danielgtaylor/huma/v2/adapters/humago"
 	"github.com/internet-golf/internet-golf/pkg/db"
+	"github.com/internet-golf/internet-golf/pkg/resources"
 	"github.com/internet-golf/internet-golf/pkg/utils"
 )
 
@@ -31,20 +38,56 @@ func readAuth(api huma.API, authManager *AuthManager) func(huma.Context, func(hu
 
 		// this header is set by the internal caddy reverse-proxy when it is
 		// forwarding a request - we don't want to mistake those for "true"
-		// localhost requests
-		remoteAddr := ctx.Header("X-Forwarded-For")
-		if len(remoteAddr) == 0 {
-			remoteAddr = ctx.RemoteAddr()
+		// localhost requests. it's only believed when the request actually
+		// comes from caddy, since anyone else could set it to anything
+		remoteAddr := ctx.RemoteAddr()
+		if viaSocket, _ := ctx.Context().Value(unixSocketConnKey{}).(bool); viaSocket {
+			remoteAddr = UnixSocketRemoteAddr
+		} else if forwardedFor := ctx.Header("X-Forwarded-For"); len(forwardedFor) > 0 && isLoopback(remoteAddr) {
+			remoteAddr = forwardedFor
 		}
+		ctx = huma.WithValue(ctx, "remoteAddr", remoteAddr)
+
+		// logging in has to work without credentials, since that's how
+		// credentials are gotten
+		if op := ctx.Operation(); op != nil && op.Metadata[skipAuthMetadata] == true {
+			next(ctx)
+			return
+		}
+
 		authHeader := ctx.Header("Authorization")
+		if len(authHeader) == 0 {
+			if cookie, err := huma.ReadCookie(ctx, SessionCookieName); err == nil && len(cookie.Value) > 0 {
+				// browsers send cookies along with requests that other sites
+				// make, so anything that changes things also needs the csrf
+				// token, which other sites can't get
+				switch ctx.Method() {
+				case http.MethodGet, http.MethodHead, http.MethodOptions:
+				default:
+					expected := CsrfTokenForSession(cookie.Value)
+					if subtle.ConstantTimeCompare([]byte(ctx.Header(CsrfHeaderName)), []byte(expected)) != 1 {
+						huma.WriteErr(api, ctx, ErrInvalidCsrfToken.Status, ErrInvalidCsrfToken.Error())
+						return
+					}
+				}
+				authHeader = "Session " + cookie.Value
+			}
+		}
 
-		// TODO: recover from any panics in getPermissionForRequest?
-		permissions, error := authManager.GetPermissionsForRequest(remoteAddr, authHeader)
-		if error != nil {
-			fmt.Fprintf(os.Stderr, "Error getting permissions for request: %s\n", error.Error())
-		} else {
-			ctx = huma.WithValue(ctx, "permissions", permissions)
+		permissions, err := authManager.GetPermissionsForRequest(remoteAddr, authHeader)
+		var authErr *AuthError
+		if errors.As(err, &authErr) {
+			if authErr.RetryAfter > 0 {
+				ctx.SetHeader("Retry-After", strconv.Itoa(int(authErr.RetryAfter.Seconds())+1))
+			}
+			huma.WriteErr(api, ctx, authErr.Status, err.Error())
+			return
+		} else if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting permissions for request: %s\n", err.Error())
+			huma.WriteErr(api, ctx, http.StatusInternalServerError, "Could not check credentials")
+			return
 		}
+		ctx = huma.WithValue(ctx, "permissions", permissions)
 
 		next(ctx)
 	}
@@ -53,7 +96,8 @@ func readAuth(api huma.API, authManager *AuthManager) func(huma.Context, func(hu
 type AddExternalUserBody struct {
 	ExternalUserHandle string                `json:"externalUserHandle,omitempty" docs:"A username, like \"internet-golf\" for Github user @internet-golf. Will be ignored if externalUserId is specified."`
 	ExternalUserId     string                `json:"externalUserId,omitempty" docs:"The ID that the user has in the external system. Not needed if externalUserHandle is specified."`
-	ExternalUserSource db.ExternalSourceType `json:"externalUserSource" docs:"The location of the external user. Currently only supports \"Github\"."`
+	ExternalUserSource db.ExternalSourceType `json:"externalUserSource" docs:"The location of the external user. This has to be the name of one of the server's OIDC issuers, like \"Github\"."`
+	Scopes             []ScopeModel          `json:"scopes,omitempty" required:"false" doc:"What the user can do. If there aren't any scopes, the user gets full permissions."`
 }
 type AddExternalUserInput struct {
 	Body struct {
@@ -61,27 +105,62 @@ type AddExternalUserInput struct {
 	}
 }
 
-type CreateBearerTokenBody struct {
-	FullPermissions bool `json:"fullPermissions"`
-	// TODO: more granular permissions
+type ScopeModel struct {
+	Urls        []string `json:"urls,omitempty" required:"false" doc:"URLs of deployments that this scope covers." example:"[\"mysite.com\"]"`
+	UrlPrefixes []string `json:"urlPrefixes,omitempty" required:"false" doc:"This scope covers deployments whose URLs start with one of these. A prefix only matches up to the end of the domain or a slash, so \"mysite.com\" covers \"mysite.com/blog\" but not \"mysite.com.example.org\"." example:"[\"staging.mysite.com\"]"`
+	Tags        []string `json:"tags,omitempty" required:"false" doc:"This scope covers deployments that have one of these tags."`
+	Actions     []string `json:"actions" enum:"view,deploy,modify,delete" doc:"What can be done with the deployments that this scope covers. \"deploy\" is putting new content in them (or rolling them back), and \"modify\" is changing their settings or creating new ones with the URLs (but not tags) that this scope covers. All of the actions include \"view\"."`
 }
 
-type CreateBearerTokenInput struct {
+// turns scopes from the api into scopes for the database
+func scopesFromModels(models []ScopeModel) ([]db.Scope, error) {
+	scopes := []db.Scope{}
+	for _, model := range models {
+		if len(model.Actions) == 0 {
+			return nil, fmt.Errorf("scopes need at least one action")
+		}
+		if len(model.Urls) == 0 && len(model.UrlPrefixes) == 0 && len(model.Tags) == 0 {
+			return nil, fmt.Errorf("scopes need at least one URL, URL prefix, or tag")
+		}
+		scope := db.Scope{UrlPrefixes: model.UrlPrefixes, Tags: model.Tags}
+		// the urls are compared to the deployments' urls as strings, so they
+		// go through the same parsing first
+		for _, url := range model.Urls {
+			scope.Urls = append(scope.Urls, urlFromString(url).String())
+		}
+		for _, action := range model.Actions {
+			scope.Actions = append(scope.Actions, db.Action(action))
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+type SuccessOutput struct {
 	Body struct {
-		CreateBearerTokenBody
+		Success  bool     `json:"success"`
+		Message  string   `json:"message"`
+		Warnings []string `json:"warnings,omitempty" doc:"Problems that didn't stop the request from succeeding, like lines in a _redirects file that couldn't be used."`
 	}
 }
 
-type CreateBearerTokenOutput struct {
+type CollectGarbageInput struct {
 	Body struct {
-		Token string `json:"token"`
+		DryRun bool `json:"dryRun" required:"false" doc:"If true, report what would be deleted without deleting anything."`
 	}
 }
 
-type SuccessOutput struct {
+type OrphanedDirectoryModel struct {
+	Path          string `json:"path" doc:"Location of the directory on the server."`
+	Size          int64  `json:"size" doc:"Total size of the files in the directory, in bytes."`
+	OrphanedSince string `json:"orphanedSince" doc:"When the directory was first found to be unused (string in ISO-8601 format.)"`
+}
+
+type CollectGarbageOutput struct {
 	Body struct {
-		Success bool   `json:"success"`
-		Message string `json:"message"`
+		Deleted    []OrphanedDirectoryModel `json:"deleted" doc:"Directories that were deleted (or would have been, for a dry run.)"`
+		Pending    []OrphanedDirectoryModel `json:"pending" doc:"Unused directories that are still within the grace period and will be deleted later."`
+		FreedBytes int64                    `json:"freedBytes" doc:"Total size of the deleted directories."`
 	}
 }
 
@@ -94,17 +173,25 @@ type HealthCheckOutput struct {
 type AdminApi struct {
 	web    *DeploymentBus
 	auth   *AuthManager
+	db     db.Db
 	config *utils.Config
 }
 
 func NewAdminApi(bus *DeploymentBus, db db.Db, config *utils.Config) *AdminApi {
 	return &AdminApi{
 		web:    bus,
-		auth:   NewAuthManager(db),
+		auth:   NewAuthManager(db, config),
+		db:     db,
 		config: config,
 	}
 }
 
+func (a *AdminApi) hasOidcIssuer(name string) bool {
+	return slices.ContainsFunc(a.config.OidcIssuers, func(issuer utils.OidcIssuer) bool {
+		return issuer.Name == name
+	})
+}
+
 var humaConfig = huma.DefaultConfig("Internet Golf API", "0.5.0")
 
 // this function sets up the endpoints for the server's admin API. note that the
@@ -137,7 +224,13 @@ func (a *AdminApi) addRoutes(api huma.API) {
 		}
 
 		if !permissions.CanCreateCredentials() {
-			return nil, huma.Error401Unauthorized("You are not authorized to add a user")
+			return nil, huma.Error403Forbidden("You are not authorized to add a user")
+		}
+
+		if !a.hasOidcIssuer(string(input.Body.ExternalUserSource)) {
+			return nil, huma.Error400BadRequest(
+				fmt.Sprintf("there's no OIDC issuer named \"%s\"", input.Body.ExternalUserSource),
+			)
 		}
 
 		if len(input.Body.ExternalUserHandle) == 0 && len(input.Body.ExternalUserId) == 0 {
@@ -168,16 +261,28 @@ func (a *AdminApi) addRoutes(api huma.API) {
 				}
 				input.Body.ExternalUserId = strconv.FormatInt(apiObj.Id, 10)
 			} else {
-				return nil, huma.Error400BadRequest("External source of user not recognized")
+				return nil, huma.Error400BadRequest(
+					fmt.Sprintf("Users from %s have to be registered by ID", input.Body.ExternalUserSource),
+				)
 			}
 		}
 
-		a.auth.RegisterExternalUser(db.ExternalUser{
-			ExternalSource: input.Body.ExternalUserSource,
-			ExternalId:     input.Body.ExternalUserId,
-			// defaulting to full permissions until more granular permissions are added
-			FullPermissions: true,
-		})
+		scopes, err := scopesFromModels(input.Body.Scopes)
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
+		auditDetailsFrom(ctx).Target = fmt.Sprintf(
+			"%s user %s", input.Body.ExternalUserSource, input.Body.ExternalUserId,
+		)
+		if err := a.auth.RegisterExternalUser(db.ExternalUser{
+			ExternalSource:  input.Body.ExternalUserSource,
+			ExternalId:      input.Body.ExternalUserId,
+			FullPermissions: len(scopes) == 0,
+			Scopes:          scopes,
+		}); err != nil {
+			return nil, huma.Error500InternalServerError("Could not save user: " + err.Error())
+		}
 
 		var output SuccessOutput
 		output.Body.Success = true
@@ -189,23 +294,51 @@ func (a *AdminApi) addRoutes(api huma.API) {
 
 	// TODO: get (all?) users endpoint
 
-	huma.Post(api, "/token/generate", func(ctx context.Context, input *CreateBearerTokenInput) (*CreateBearerTokenOutput, error) {
-		token, err := a.auth.CreateBearerToken(input.Body.FullPermissions)
+	huma.Register(api, huma.Operation{
+		OperationID: "CollectGarbage",
+		Description: "Delete deployment content that isn't used by any deployment or revision anymore.",
+		Method:      http.MethodPost,
+		Path:        "/gc",
+	}, func(ctx context.Context, input *CollectGarbageInput) (*CollectGarbageOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		if !permissions.CanManageServer() {
+			return nil, huma.Error403Forbidden("Not authorized to delete deployment content")
+		}
+
+		report, err := a.web.CollectGarbage(input.Body.DryRun)
 		if err != nil {
-			return nil, huma.Error500InternalServerError("Could not generate token: " + err.Error())
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+
+		toModels := func(dirs []resources.OrphanedDirectory) []OrphanedDirectoryModel {
+			models := []OrphanedDirectoryModel{}
+			for _, d := range dirs {
+				models = append(models, OrphanedDirectoryModel{
+					Path:          d.Path,
+					Size:          d.Size,
+					OrphanedSince: d.OrphanedSince.UTC().Format(time.RFC3339),
+				})
+			}
+			return models
 		}
 
-		var output CreateBearerTokenOutput
-		output.Body.Token = token
+		var output CollectGarbageOutput
+		output.Body.Deleted = toModels(report.Deleted)
+		output.Body.Pending = toModels(report.Pending)
+		for _, d := range report.Deleted {
+			output.Body.FreedBytes += d.Size
+		}
 		return &output, nil
 	})
 
-	// huma.Put(api, "/deploy/container", func(
-	// 	ctx context.Context, input *DeployContainerInput,
-	// ) (*SuccessOutput, error) {
-	// 	// TODO: implement this at all
-	// 	panic("not implemented")
-	// })
+	a.addTokenRoutes(api)
+	a.addMetricsRoutes(api)
+	a.addSessionRoutes(api)
+	a.addAuditRoutes(api)
 }
 
 func (a *AdminApi) OutputOpenApiSpec(outputPath string) {
@@ -222,6 +355,14 @@ func (a *AdminApi) OutputOpenApiSpec(outputPath string) {
 	}
 }
 
+// marks the contexts of requests that come in over the admin api's unix socket
+type unixSocketConnKey struct{}
+
+func isLoopback(remoteAddr string) bool {
+	addr, err := netip.ParseAddr(clientIp(remoteAddr))
+	return err == nil && addr.Unmap().IsLoopback()
+}
+
 func (a *AdminApi) CreateServer() *http.Server {
 	if len(a.config.AdminApiPort) == 0 {
 		panic("Admin API port not set")
@@ -230,7 +371,9 @@ func (a *AdminApi) CreateServer() *http.Server {
 	router := http.NewServeMux()
 	api := humago.New(router, humaConfig)
 
-	api.UseMiddleware(readAuth(api, a.auth))
+	api.UseMiddleware(
+		limitUploadBodies(a.config.MaxUploadSize), readAuth(api, a.auth), recordAudit(a.db),
+	)
 
 	a.addRoutes(api)
 
@@ -239,6 +382,44 @@ func (a *AdminApi) CreateServer() *http.Server {
 	if a.config.LocalOnly {
 		address = "127.0.0.1"
 	}
-	server := http.Server{Addr: address + ":" + a.config.AdminApiPort, Handler: router}
+	server := http.Server{
+		Addr:    address + ":" + a.config.AdminApiPort,
+		Handler: router,
+		ConnContext: func(ctx context.Context, conn net.Conn) context.Context {
+			if _, isUnix := conn.(*net.UnixConn); isUnix {
+				return context.WithValue(ctx, unixSocketConnKey{}, true)
+			}
+			return ctx
+		},
+	}
 	return &server
 }
+
+// starts serving the admin api on the unix socket from the config too, if
+// there is one. the socket is only usable by the server's user and group, so
+// file permissions decide who can use it
+func (a *AdminApi) ServeSocket(server *http.Server) error {
+	if len(a.config.AdminApiSocket) == 0 {
+		return nil
+	}
+	// a socket file left over from the last time the server ran would keep
+	// the new one from being created
+	if err := os.Remove(a.config.AdminApiSocket); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not remove old admin api socket: %w", err)
+	}
+	listener, err := net.Listen("unix", a.config.AdminApiSocket)
+	if err != nil {
+		return fmt.Errorf("could not listen on admin api socket: %w", err)
+	}
+	if err := os.Chmod(a.config.AdminApiSocket, 0660); err != nil {
+		listener.Close()
+		return fmt.Errorf("could not set admin api socket permissions: %w", err)
+	}
+	fmt.Println("Starting admin API server at unix://" + a.config.AdminApiSocket)
+	go func() {
+		if err := server.Serve(listener); err != http.ErrServerClosed {
+			fmt.Fprintf(os.Stderr, "Admin API socket stopped: %v\n", err)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/internet-golf/internet-golf/pkg/db"
+)
+
+// operations with this in their metadata aren't recorded in the audit log,
+// even though they aren't GET requests, since they don't change anything
+const skipAuditMetadata = "skipAudit"
+
+// what a handler knows about the request that the audit log middleware doesn't
+type auditDetails struct {
+	Target      string
+	ContentHash string
+	// for requests that don't have permissions, like logging in
+	Actor string
+	// for requests that turned out to not do anything worth recording
+	Skip bool
+}
+
+// returns the audit details for the request, which handlers can fill in. if
+// the request isn't being recorded, the details just aren't used
+func auditDetailsFrom(ctx context.Context) *auditDetails {
+	if details, ok := ctx.Value("audit").(*auditDetails); ok {
+		return details
+	}
+	return &auditDetails{}
+}
+
+// returns a huma middleware function that adds an entry to the audit log for
+// every request that could change something. this has to come after readAuth,
+// since it uses the permissions to find out who made the request; requests
+// that readAuth turns away don't get this far, so they aren't recorded
+func recordAudit(database db.Db) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		op := ctx.Operation()
+		switch ctx.Method() {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next(ctx)
+			return
+		}
+		if op == nil || op.Metadata[skipAuditMetadata] == true {
+			next(ctx)
+			return
+		}
+
+		details := &auditDetails{}
+		next(huma.WithValue(ctx, "audit", details))
+		if details.Skip {
+			return
+		}
+
+		entry := db.AuditEntry{
+			Time:        time.Now(),
+			Actor:       details.Actor,
+			Action:      op.OperationID,
+			Target:      details.Target,
+			ContentHash: details.ContentHash,
+			Status:      ctx.Status(),
+		}
+		if permissions, ok := ctx.Context().Value("permissions").(Permissions); ok {
+			entry.Actor = permissions.Identity()
+		}
+		if remoteAddr, ok := ctx.Context().Value("remoteAddr").(string); ok {
+			entry.RemoteAddr = remoteAddr
+		}
+		if entry.Status == 0 {
+			entry.Status = http.StatusOK
+		}
+		switch {
+		case entry.Status < 400:
+			entry.Outcome = db.AuditSuccess
+		case entry.Status == http.StatusUnauthorized || entry.Status == http.StatusForbidden:
+			entry.Outcome = db.AuditDenied
+		default:
+			entry.Outcome = db.AuditFailed
+		}
+
+		// the response has already been sent, so this can only be logged
+		if err := database.AddAuditEntry(entry); err != nil {
+			fmt.Fprintf(os.Stderr, "Could not add audit log entry for %s: %v\n", op.OperationID, err)
+		}
+	}
+}
+
+type GetAuditLogInput struct {
+	Actor   string `query:"actor" doc:"Only include entries whose actor contains this, like a token ID or a Github username."`
+	Action  string `query:"action" doc:"Only include entries for this action, like \"DeployFiles\"." example:"DeployFiles"`
+	Target  string `query:"target" doc:"Only include entries for this deployment URL, token ID, or user."`
+	Outcome string `query:"outcome" enum:"success,denied,failed," doc:"Only include entries with this outcome."`
+	Since   string `query:"since" doc:"Only include entries from after this. Can be a time, like \"2025-01-02T15:04:05Z\", or how long ago, like \"24h\"."`
+	Until   string `query:"until" doc:"Only include entries from before this, in the same formats as since."`
+	Limit   int    `query:"limit" default:"100" minimum:"0" doc:"The most entries to return, starting from the newest. 0 means no limit."`
+}
+
+type AuditEntryModel struct {
+	Time        string `json:"time" format:"date-time"`
+	Actor       string `json:"actor" doc:"Who did it, like \"token 1a2b3c4d\", \"github:someone (owner/repo, run 123)\", or \"local\"."`
+	Action      string `json:"action" doc:"The ID of the API operation, like \"DeployFiles\"."`
+	Target      string `json:"target,omitempty" required:"false" doc:"The deployment URL, token ID, or user that was affected."`
+	ContentHash string `json:"contentHash,omitempty" required:"false" doc:"The hash of the content that was deployed, or the image for containers."`
+	Status      int    `json:"status" doc:"The HTTP status of the response."`
+	Outcome     string `json:"outcome" enum:"success,denied,failed"`
+	RemoteAddr  string `json:"remoteAddr,omitempty" required:"false"`
+}
+
+type GetAuditLogOutput struct {
+	Body struct {
+		Entries []AuditEntryModel `json:"entries" required:"true" doc:"Matching entries, newest first."`
+	}
+}
+
+// parses an rfc3339 time or a duration, which is taken to mean that long ago
+func parseAuditTime(value string) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if ago, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-ago), nil
+	}
+	return time.Time{}, fmt.Errorf("%q isn't a time or a duration", value)
+}
+
+func (a *AdminApi) addAuditRoutes(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "GetAuditLog",
+		Description: "Get entries from the log of everything that has been done with the admin API, newest first.",
+		Method:      http.MethodGet,
+		Path:        "/audit",
+	}, func(ctx context.Context, input *GetAuditLogInput) (*GetAuditLogOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		if !permissions.CanManageServer() {
+			return nil, huma.Error403Forbidden("Not authorized to view the audit log")
+		}
+
+		since, err := parseAuditTime(input.Since)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid since: " + err.Error())
+		}
+		until, err := parseAuditTime(input.Until)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid until: " + err.Error())
+		}
+
+		entries, err := a.db.GetAuditEntries(db.AuditFilter{
+			Actor:   input.Actor,
+			Action:  input.Action,
+			Target:  input.Target,
+			Outcome: db.AuditOutcome(input.Outcome),
+			Since:   since,
+			Until:   until,
+			Limit:   input.Limit,
+		})
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Could not get audit log: " + err.Error())
+		}
+
+		var output GetAuditLogOutput
+		output.Body.Entries = []AuditEntryModel{}
+		for _, entry := range entries {
+			output.Body.Entries = append(output.Body.Entries, AuditEntryModel{
+				Time:        entry.Time.UTC().Format(time.RFC3339),
+				Actor:       entry.Actor,
+				Action:      entry.Action,
+				Target:      entry.Target,
+				ContentHash: entry.ContentHash,
+				Status:      entry.Status,
+				Outcome:     string(entry.Outcome),
+				RemoteAddr:  entry.RemoteAddr,
+			})
+		}
+		return &output, nil
+	})
+}
@@ -1,90 +1,373 @@
 package api
 
 import (
+	"errors"
 	"fmt"
-	"net"
+	"net/http"
+	"net/netip"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/internet-golf/internet-golf/pkg/db"
 	"github.com/internet-golf/internet-golf/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type AuthManager struct {
-	db db.Db
+	db       db.Db
+	config   *utils.Config
+	failures *failureLimiter
+	oidcKeys *oidcKeyCache
+	metrics  *authMetrics
 }
 
-func NewAuthManager(db db.Db) *AuthManager {
+func NewAuthManager(db db.Db, config *utils.Config) *AuthManager {
+	metrics := newAuthMetrics()
 	return &AuthManager{
-		db: db,
+		db:       db,
+		config:   config,
+		failures: newFailureLimiter(),
+		oidcKeys: newOidcKeyCache(config, metrics),
+		metrics:  metrics,
 	}
 }
 
+// the registry with the metrics about authentication, like how long it takes
+// to verify oidc tokens
+func (a *AuthManager) Metrics() *prometheus.Registry {
+	return a.metrics.registry
+}
+
+// an error that comes from checking the credentials that a request was made
+// with, along with the http status that it should be reported with
+type AuthError struct {
+	Status int
+	Reason string
+	// how long the client should wait before trying again, if it should
+	RetryAfter time.Duration
+}
+
+func (e *AuthError) Error() string {
+	return e.Reason
+}
+
+// auth errors are the same kind of error if they have the same status and
+// reason, so that errors.Is still works for lockouts, which each have their own
+// RetryAfter
+func (e *AuthError) Is(target error) bool {
+	t, ok := target.(*AuthError)
+	return ok && t.Status == e.Status && t.Reason == e.Reason
+}
+
+var (
+	ErrNoCredentials = &AuthError{Status: http.StatusUnauthorized, Reason: "no credentials were given"}
+	// the authorization header isn't in a format that any of the checkers
+	// understand
+	ErrMalformedCredentials = &AuthError{Status: http.StatusUnauthorized, Reason: "malformed credentials"}
+	// this is used for both tokens that don't exist and tokens that don't
+	// match, so that the response doesn't reveal which token ids exist
+	ErrInvalidCredentials = &AuthError{Status: http.StatusUnauthorized, Reason: "invalid credentials"}
+	ErrTokenExpired       = &AuthError{Status: http.StatusUnauthorized, Reason: "this token has expired"}
+	ErrTokenRevoked       = &AuthError{Status: http.StatusUnauthorized, Reason: "this token has been revoked"}
+	ErrTooManyFailures    = &AuthError{
+		Status: http.StatusTooManyRequests, Reason: "too many failed attempts to authenticate; try again later",
+	}
+)
+
 func (a *AuthManager) GetPermissionsForRequest(remoteAddr string, authHeader string) (Permissions, error) {
-	if l := (LocalReqAuthChecker{}); l.setReqData(remoteAddr, authHeader) {
+	local := &LocalReqAuthChecker{Db: a.db, Config: a.config}
+	if trusted, err := local.setReqData(remoteAddr, authHeader); err != nil {
+		return nil, err
+	} else if trusted {
 		fmt.Println("automatically trusting request from " + remoteAddr)
-		return &l, nil
-	} else if g := (GithubAuthChecker{Db: a.db}); g.setReqData(remoteAddr, authHeader) {
-		return &g, nil
-	} else if b := (BearerTokenAuthChecker{Db: a.db}); b.setReqData(remoteAddr, authHeader) {
-		return &b, nil
+		return local, nil
 	}
-	if len(authHeader) > 0 {
-		return nil, fmt.Errorf("could not check auth for header value \"%s\"", authHeader)
-	} else {
-		return nil, fmt.Errorf("no auth header present")
+	if len(authHeader) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	// this is checked before the credentials are, so that locked-out clients
+	// can't make the server do any more bcrypt work
+	ip := clientIp(remoteAddr)
+	if err := a.lockoutError(ip); err != nil {
+		return nil, err
+	}
+
+	checkers := []Permissions{
+		&OidcAuthChecker{Db: a.db, Issuers: a.config.OidcIssuers, keys: a.oidcKeys},
+		&BearerTokenAuthChecker{Db: a.db},
+		&SessionAuthChecker{Db: a.db},
 	}
+	for _, checker := range checkers {
+		applies, err := checker.setReqData(remoteAddr, authHeader)
+		if !applies {
+			continue
+		}
+		if errors.Is(err, ErrInvalidCredentials) || errors.Is(err, ErrMalformedCredentials) {
+			a.failures.record(ip)
+		} else if err == nil {
+			a.failures.reset(ip)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return checker, nil
+	}
+	a.failures.record(ip)
+	return nil, fmt.Errorf("%w: unrecognized authorization scheme", ErrMalformedCredentials)
+}
+
+// returns an error if the ip address has failed to authenticate too many times
+// recently
+func (a *AuthManager) lockoutError(ip string) error {
+	if wait := a.failures.lockedOutFor(ip); wait > 0 {
+		return &AuthError{
+			Status: ErrTooManyFailures.Status, Reason: ErrTooManyFailures.Reason, RetryAfter: wait,
+		}
+	}
+	return nil
 }
 
-func (a *AuthManager) RegisterExternalUser(e db.ExternalUser) {
-	a.db.SaveExternalUser(e)
+func (a *AuthManager) RegisterExternalUser(e db.ExternalUser) error {
+	return a.db.SaveExternalUser(e)
 }
 
-func (a *AuthManager) CreateBearerToken(fullPermissions bool) (string, error) {
-	return (&BearerTokenAuthChecker{Db: a.db}).CreateBearerToken(fullPermissions)
+// creates a token with the name, permissions, and expiry of the one that's
+// passed in, and returns it in the format that's used in auth headers
+func (a *AuthManager) CreateBearerToken(token db.BearerToken) (string, error) {
+	return (&BearerTokenAuthChecker{Db: a.db}).CreateBearerToken(token)
+}
+
+// like CreateBearerToken, but fails with db.ErrBearerTokensExist if another
+// token has been created already. this is what requests that are only trusted
+// for bootstrapping get to use
+func (a *AuthManager) CreateFirstBearerToken(token db.BearerToken) (string, error) {
+	token, value, err := newBearerToken(a.db, token)
+	if err != nil {
+		return "", err
+	}
+	if err := a.db.SaveFirstBearerToken(token); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+func (a *AuthManager) GetBearerTokens() ([]db.BearerToken, error) {
+	tokens, err := a.db.GetBearerTokens()
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(tokens, func(x db.BearerToken, y db.BearerToken) int {
+		return x.CreatedAt.Compare(y.CreatedAt)
+	})
+	return tokens, nil
+}
+
+func (a *AuthManager) RevokeBearerToken(id string) error {
+	token, err := a.db.GetBearerToken(id)
+	if err != nil {
+		return fmt.Errorf("could not find token with id %s", id)
+	}
+	if !token.RevokedAt.IsZero() {
+		return nil
+	}
+	token.RevokedAt = time.Now()
+	return a.db.SaveBearerToken(token)
+}
+
+// replaces the secret part of the token, so that the old value stops working.
+// if expiresAt isn't zero, it becomes the token's new expiry
+func (a *AuthManager) RotateBearerToken(id string, expiresAt time.Time) (string, error) {
+	token, err := a.db.GetBearerToken(id)
+	if err != nil {
+		return "", fmt.Errorf("could not find token with id %s", id)
+	}
+	if !token.RevokedAt.IsZero() {
+		return "", fmt.Errorf("token %s has been revoked", id)
+	}
+	if !expiresAt.IsZero() {
+		token.ExpiresAt = expiresAt
+	}
+	if !token.ExpiresAt.IsZero() && token.ExpiresAt.Before(time.Now()) {
+		return "", fmt.Errorf("token %s has expired, so it needs a new expiry to be rotated", id)
+	}
+
+	secret, _ := utils.GetRandomToken()
+	if token.TokenHash, err = bcrypt.GenerateFromPassword([]byte(secret), bearerTokenCost); err != nil {
+		return "", err
+	}
+	token.RotatedAt = time.Now()
+	if err := a.db.SaveBearerToken(token); err != nil {
+		return "", err
+	}
+	return token.Id + "." + secret, nil
 }
 
 type Permissions interface {
 	// returns false if the given concrete implementation of Permissions is not
-	// suitable for the given request data
-	setReqData(remoteAddr string, authHeader string) bool
-	CanCreateDeployment() bool
+	// suitable for the given request data, and an error if it is suitable but
+	// the credentials don't check out
+	setReqData(remoteAddr string, authHeader string) (bool, error)
+	// can create a new deployment at the url
+	CanCreateDeployment(url db.Url) bool
+	// can change the deployment's settings
 	CanModifyDeployment(d *db.Deployment) bool
+	// can put new content in the deployment, or roll it back
+	CanDeployToDeployment(d *db.Deployment) bool
+	CanDeleteDeployment(d *db.Deployment) bool
 	CanViewDeployment(d *db.Deployment) bool
+	// can create preview deployments under the parent deployment's
+	// PreviewDomain
+	CanCreatePreview(parent *db.Deployment) bool
 	// can add external users and bearer tokens
 	CanCreateCredentials() bool
+	// can create the first bearer token, if there aren't any yet. this is the
+	// only thing that local requests can do in bootstrap mode
+	CanBootstrapToken() bool
+	// can do things that affect the whole server, like collecting garbage
+	CanManageServer() bool
+	// a short description of who is making the request, for the record
+	Identity() string
 }
 
-// if a request comes from the same machine as the server (i.e. comes from
-// 127.0.0.1), this lets it do whatever it wants.
+// whether any of the scopes let the action be done with the deployment
+func scopesAllow(scopes []db.Scope, action db.Action, d *db.Deployment) bool {
+	for _, scope := range scopes {
+		if action != db.ViewAction && !slices.Contains(scope.Actions, action) {
+			continue
+		}
+		if scopeCoversUrl(scope, d.Url) {
+			return true
+		}
+		for _, tag := range scope.Tags {
+			if slices.Contains(d.Tags, tag) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// whether any of the scopes let a new deployment be created at the url. tags
+// don't count for this, since whoever's creating the deployment would be the
+// one choosing its tags
+func scopesAllowCreating(scopes []db.Scope, url db.Url) bool {
+	for _, scope := range scopes {
+		if slices.Contains(scope.Actions, db.ModifyAction) && scopeCoversUrl(scope, url) {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixes only match at the end of the domain or at a slash, so that
+// "mysite.com" covers "mysite.com/blog" but not "mysite.com.evil.org", and
+// "mysite.com/blog" doesn't cover "mysite.com/blogs"
+func scopeCoversUrl(scope db.Scope, url db.Url) bool {
+	if slices.Contains(scope.Urls, url.String()) {
+		return true
+	}
+	for _, prefix := range scope.UrlPrefixes {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if url.String() == prefix || strings.HasPrefix(url.String(), prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// the remote address that readAuth uses for requests that come in over the
+// admin api's unix socket
+const UnixSocketRemoteAddr = "unix"
+
+// if a request comes from a trusted source (by default, the same machine as the
+// server), this lets it do whatever it wants, or, in bootstrap mode, create the
+// first token.
 //
 // this is similar to how you can access caddy's admin api from the same machine
 // of it and just do whatever.
 //
 // implements the interface `Permissions`.
-type LocalReqAuthChecker struct{}
-
-func (l *LocalReqAuthChecker) setReqData(remoteAddr string, authHeader string) bool {
-	// this allows requests from localhost or from other entities that have the
-	// ability to give themselves the hostname "golf-client" on the local
-	// network (which pretty much just means docker containers in the same
-	// virtual network)
-	serverAddr, _ := net.LookupIP("golf-client")
-	return (remoteAddr == "127.0.0.1" || strings.HasPrefix(remoteAddr, "127.0.0.1:") ||
-		remoteAddr == "[::1]" || strings.HasPrefix(remoteAddr, "[::1]:") ||
-		(len(serverAddr) > 0 && strings.HasPrefix(remoteAddr, serverAddr[0].String()+":")))
+type LocalReqAuthChecker struct {
+	Db     db.Db
+	Config *utils.Config
+	// in bootstrap mode, creating the first token is the only thing that
+	// trusted requests can do
+	bootstrapOnly bool
+}
+
+func (l *LocalReqAuthChecker) setReqData(remoteAddr string, authHeader string) (bool, error) {
+	if l.Config.LocalTrust == utils.LocalTrustOff || !l.isTrustedSource(remoteAddr) {
+		return false, nil
+	}
+	if l.Config.LocalTrust == utils.LocalTrustFull {
+		return true, nil
+	}
+	// in bootstrap mode, requests with credentials are checked like any
+	// others, and requests without them are only trusted until there's a token
+	if len(authHeader) > 0 {
+		return false, nil
+	}
+	tokens, err := l.Db.GetBearerTokens()
+	if err != nil {
+		return false, err
+	}
+	if len(tokens) > 0 {
+		return false, nil
+	}
+	l.bootstrapOnly = true
+	return true, nil
+}
+func (l *LocalReqAuthChecker) isTrustedSource(remoteAddr string) bool {
+	if remoteAddr == UnixSocketRemoteAddr {
+		return len(l.Config.AdminApiSocket) > 0
+	}
+	addr, err := netip.ParseAddr(clientIp(remoteAddr))
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, network := range l.Config.TrustedNetworks {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+func (l *LocalReqAuthChecker) CanCreateDeployment(_ db.Url) bool {
+	return !l.bootstrapOnly
 }
 func (l *LocalReqAuthChecker) CanModifyDeployment(_ *db.Deployment) bool {
-	return true
+	return !l.bootstrapOnly
 }
-func (l *LocalReqAuthChecker) CanCreateDeployment() bool {
-	return true
+func (l *LocalReqAuthChecker) CanDeployToDeployment(_ *db.Deployment) bool {
+	return !l.bootstrapOnly
+}
+func (l *LocalReqAuthChecker) CanDeleteDeployment(_ *db.Deployment) bool {
+	return !l.bootstrapOnly
 }
 func (l *LocalReqAuthChecker) CanViewDeployment(_ *db.Deployment) bool {
-	return true
+	return !l.bootstrapOnly
+}
+func (l *LocalReqAuthChecker) CanCreatePreview(_ *db.Deployment) bool {
+	return !l.bootstrapOnly
 }
 func (l *LocalReqAuthChecker) CanCreateCredentials() bool {
-	return true
+	return !l.bootstrapOnly
+}
+func (l *LocalReqAuthChecker) CanBootstrapToken() bool {
+	return l.bootstrapOnly
+}
+func (l *LocalReqAuthChecker) CanManageServer() bool {
+	return !l.bootstrapOnly
+}
+func (l *LocalReqAuthChecker) Identity() string {
+	if l.bootstrapOnly {
+		return "local (bootstrapping)"
+	}
+	return "local"
 }
 
 // provides bearer token-based authorization. implements the Permissions interface.
@@ -93,56 +376,128 @@ type BearerTokenAuthChecker struct {
 	token db.BearerToken
 }
 
-func (b *BearerTokenAuthChecker) CreateBearerToken(fullPermissions bool) (string, error) {
-	var token, id string
+// bcrypt's work factor for bearer tokens
+const bearerTokenCost = 14
+
+// how often a token's LastUsedAt is updated, so that every request doesn't
+// have to write to the database
+const tokenUseRecordInterval = time.Minute
+
+func (b *BearerTokenAuthChecker) CreateBearerToken(token db.BearerToken) (string, error) {
+	token, value, err := newBearerToken(b.Db, token)
+	if err != nil {
+		return "", err
+	}
+	if err := b.Db.SaveBearerToken(token); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// fills in the token's id, hash, and creation time, without saving it, and
+// returns it along with its value in the format that's used in auth headers
+func newBearerToken(database db.Db, token db.BearerToken) (db.BearerToken, string, error) {
+	var secret, id string
 	for {
-		token, id = utils.GetRandomToken()
-		existing, err := b.Db.GetBearerToken(id)
+		secret, id = utils.GetRandomToken()
+		existing, err := database.GetBearerToken(id)
 		if err != nil && len(existing.Id) == 0 {
 			break
 		}
 	}
-	tokenHash, err := bcrypt.GenerateFromPassword([]byte(token), 14)
+	tokenHash, err := bcrypt.GenerateFromPassword([]byte(secret), bearerTokenCost)
 	if err != nil {
-		return "", err
+		return db.BearerToken{}, "", err
 	}
-	b.Db.SaveBearerToken(db.BearerToken{Id: id, TokenHash: tokenHash, FullPermissions: fullPermissions})
-	return id + "." + token, nil
+	token.Id = id
+	token.TokenHash = tokenHash
+	token.CreatedAt = time.Now()
+	return token, id + "." + secret, nil
 }
 
-func (b *BearerTokenAuthChecker) setReqData(remoteAddr string, authHeader string) bool {
+func (b *BearerTokenAuthChecker) setReqData(remoteAddr string, authHeader string) (bool, error) {
 	comps := strings.Split(authHeader, " ")
 	if len(comps) != 2 || comps[0] != "Bearer" {
-		return false
+		return false, nil
 	}
-	tokenWithId := comps[1]
-	tokenComps := strings.Split(tokenWithId, ".")
+	tokenComps := strings.Split(comps[1], ".")
 	if len(tokenComps) != 2 {
-		// should this be a panic? what would the implications be?
-		panic("invalid token value received; it should have the format [id].[content]")
+		return true, fmt.Errorf("%w: tokens should have the format [id].[content]", ErrMalformedCredentials)
 	}
 	id := tokenComps[0]
 	token := tokenComps[1]
 	tokenStruct, tokenErr := b.Db.GetBearerToken(id)
 	if tokenErr != nil {
-		panic("could not find bearer token matching input")
+		return true, ErrInvalidCredentials
 	}
 	compareErr := bcrypt.CompareHashAndPassword(tokenStruct.TokenHash, []byte(token))
 	if compareErr != nil {
-		panic("token content is not a match")
+		return true, ErrInvalidCredentials
 	}
 	b.token = tokenStruct
-	return true
+	if err := b.checkUsable(); err != nil {
+		return true, err
+	}
+	b.recordUse()
+	return true, nil
 }
-func (b *BearerTokenAuthChecker) CanModifyDeployment(_ *db.Deployment) bool {
-	return b.token.FullPermissions
+
+// returns an error if the token has expired or been revoked
+func (b *BearerTokenAuthChecker) checkUsable() error {
+	if !b.token.RevokedAt.IsZero() {
+		return ErrTokenRevoked
+	}
+	if !b.token.ExpiresAt.IsZero() && time.Now().After(b.token.ExpiresAt) {
+		return ErrTokenExpired
+	}
+	return nil
 }
-func (b *BearerTokenAuthChecker) CanCreateDeployment() bool {
-	return b.token.FullPermissions
+
+func (b *BearerTokenAuthChecker) recordUse() {
+	if time.Since(b.token.LastUsedAt) < tokenUseRecordInterval {
+		return
+	}
+	// the token is loaded again right before it's saved, so that this is
+	// less likely to undo a revocation or rotation that just happened
+	current, err := b.Db.GetBearerToken(b.token.Id)
+	if err != nil {
+		return
+	}
+	current.LastUsedAt = time.Now()
+	if err := b.Db.SaveBearerToken(current); err != nil {
+		fmt.Printf("could not record use of token %s: %v\n", b.token.Id, err)
+	}
 }
-func (b *BearerTokenAuthChecker) CanViewDeployment(_ *db.Deployment) bool {
-	return b.token.FullPermissions
+
+func (b *BearerTokenAuthChecker) CanCreateDeployment(url db.Url) bool {
+	return b.token.FullPermissions || scopesAllowCreating(b.token.Scopes, url)
+}
+func (b *BearerTokenAuthChecker) CanModifyDeployment(d *db.Deployment) bool {
+	return b.token.FullPermissions || scopesAllow(b.token.Scopes, db.ModifyAction, d)
+}
+func (b *BearerTokenAuthChecker) CanDeployToDeployment(d *db.Deployment) bool {
+	return b.token.FullPermissions || scopesAllow(b.token.Scopes, db.DeployAction, d)
+}
+func (b *BearerTokenAuthChecker) CanDeleteDeployment(d *db.Deployment) bool {
+	return b.token.FullPermissions || scopesAllow(b.token.Scopes, db.DeleteAction, d)
+}
+func (b *BearerTokenAuthChecker) CanViewDeployment(d *db.Deployment) bool {
+	return b.token.FullPermissions || scopesAllow(b.token.Scopes, db.ViewAction, d)
+}
+
+// previews go along with deploying, since that's what they're for
+func (b *BearerTokenAuthChecker) CanCreatePreview(parent *db.Deployment) bool {
+	return b.CanDeployToDeployment(parent)
 }
 func (b *BearerTokenAuthChecker) CanCreateCredentials() bool {
 	return b.token.FullPermissions
 }
+func (b *BearerTokenAuthChecker) CanBootstrapToken() bool {
+	return false
+}
+func (b *BearerTokenAuthChecker) CanManageServer() bool {
+	return b.token.FullPermissions
+}
+func (b *BearerTokenAuthChecker) Identity() string {
+	return "token " + b.token.Id
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// how many failed attempts to authenticate an ip address gets within
+// authFailureWindow before it's locked out for authLockoutDuration
+const maxAuthFailures = 10
+const authFailureWindow = 10 * time.Minute
+const authLockoutDuration = 15 * time.Minute
+
+// once this many ip addresses are being tracked, the ones whose failures are
+// old enough to not matter anymore are dropped
+const maxTrackedAuthFailures = 10000
+
+type authFailures struct {
+	count       int
+	firstAt     time.Time
+	lockedUntil time.Time
+}
+
+// keeps track of failed attempts to authenticate by ip address, so that someone
+// guessing tokens can't make the server do an unlimited amount of bcrypt work
+type failureLimiter struct {
+	mutex    sync.Mutex
+	failures map[string]*authFailures
+}
+
+func newFailureLimiter() *failureLimiter {
+	return &failureLimiter{failures: map[string]*authFailures{}}
+}
+
+// returns how much longer the ip address is locked out for, or 0 if it isn't
+func (f *failureLimiter) lockedOutFor(ip string) time.Duration {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	entry, ok := f.failures[ip]
+	if !ok {
+		return 0
+	}
+	return max(time.Until(entry.lockedUntil), 0)
+}
+
+func (f *failureLimiter) record(ip string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	now := time.Now()
+	entry, ok := f.failures[ip]
+	if !ok || (now.Sub(entry.firstAt) > authFailureWindow && now.After(entry.lockedUntil)) {
+		if len(f.failures) >= maxTrackedAuthFailures {
+			f.prune(now)
+		}
+		entry = &authFailures{firstAt: now}
+		f.failures[ip] = entry
+	}
+	entry.count++
+	if entry.count >= maxAuthFailures {
+		entry.lockedUntil = now.Add(authLockoutDuration)
+	}
+}
+
+func (f *failureLimiter) reset(ip string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	delete(f.failures, ip)
+}
+
+func (f *failureLimiter) prune(now time.Time) {
+	for ip, entry := range f.failures {
+		if now.Sub(entry.firstAt) > authFailureWindow && now.After(entry.lockedUntil) {
+			delete(f.failures, ip)
+		}
+	}
+}
+
+// the remote address can be an ip address with or without a port, depending on
+// whether it came from the connection or from X-Forwarded-For
+func clientIp(remoteAddr string) string {
+	if addrPort, err := netip.ParseAddrPort(remoteAddr); err == nil {
+		return addrPort.Addr().String()
+	}
+	if addr, err := netip.ParseAddr(remoteAddr); err == nil {
+		return addr.String()
+	}
+	return remoteAddr
+}
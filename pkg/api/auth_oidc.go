@@ -0,0 +1,261 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/internet-golf/internet-golf/pkg/db"
+	"github.com/internet-golf/internet-golf/pkg/utils"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// the parts of an oidc token that matter for permissions, taken from whichever
+// claims the issuer puts them in
+type oidcClaims struct {
+	Repo string
+	// empty if the token wasn't issued for a branch (if it was issued for a
+	// tag, for example)
+	Branch  string
+	Actor   string
+	ActorId string
+	// empty if the issuer doesn't have a run id claim
+	RunId string
+}
+
+// provides authorization with id tokens from github actions, gitlab ci, and
+// any other oidc issuers in the config. implements the interface `Permissions`
+type OidcAuthChecker struct {
+	Db      db.Db
+	Issuers []utils.OidcIssuer
+	keys    *oidcKeyCache
+	issuer  utils.OidcIssuer
+	claims  oidcClaims
+}
+
+func (o *OidcAuthChecker) setReqData(_remoteAddr string, authHeader string) (bool, error) {
+	headerComps := strings.Split(authHeader, " ")
+	if len(headerComps) != 2 {
+		return false, nil
+	}
+	issuers := o.Issuers
+	switch headerComps[0] {
+	case "OIDC":
+	// older clients use this for github tokens specifically
+	case "GithubOIDC":
+		issuers = []utils.OidcIssuer{}
+		for _, issuer := range o.Issuers {
+			if issuer.Name == string(db.Github) {
+				issuers = append(issuers, issuer)
+			}
+		}
+	default:
+		return false, nil
+	}
+	issuer, claims, err := o.keys.parseToken(headerComps[1], issuers)
+	if err != nil {
+		return true, err
+	}
+	o.issuer = issuer
+	o.claims = claims
+	return true, nil
+}
+
+func (o *OidcAuthChecker) sourceType() db.ExternalSourceType {
+	return db.ExternalSourceType(o.issuer.Name)
+}
+
+// returns the registered user that the token belongs to. users who haven't
+// been registered don't have any permissions of their own, but can still
+// deploy from the repos that deployments are associated with
+func (o *OidcAuthChecker) externalUser() db.ExternalUser {
+	externalUser, err := o.Db.GetExternalUser(o.sourceType(), o.claims.ActorId)
+	if err != nil {
+		return db.ExternalUser{}
+	}
+	return externalUser
+}
+
+func (o *OidcAuthChecker) UserHasFullPermissions() bool {
+	return o.externalUser().FullPermissions
+}
+
+// whether the token comes from a workflow in the repo (and branch, if there
+// is one) that the deployment is associated with
+func (o *OidcAuthChecker) isFromDeploymentRepo(d *db.Deployment) bool {
+	if d.ExternalSourceType != o.sourceType() || len(o.claims.Repo) == 0 {
+		return false
+	}
+	repo := o.claims.Repo
+	return d.ExternalSource == repo ||
+		(len(o.claims.Branch) > 0 && d.ExternalSource == repo+"#"+o.claims.Branch)
+}
+
+// whether the user is allowed to do the action with the deployment, either
+// with their permissions or because they're deploying it from its own repo
+func (o *OidcAuthChecker) can(action db.Action, d *db.Deployment) bool {
+	user := o.externalUser()
+	if user.FullPermissions || scopesAllow(user.Scopes, action, d) {
+		return true
+	}
+	return (action == db.ViewAction || action == db.DeployAction) && o.isFromDeploymentRepo(d)
+}
+
+func (o *OidcAuthChecker) CanCreateDeployment(url db.Url) bool {
+	user := o.externalUser()
+	return user.FullPermissions || scopesAllowCreating(user.Scopes, url)
+}
+
+func (o *OidcAuthChecker) CanModifyDeployment(d *db.Deployment) bool {
+	return o.can(db.ModifyAction, d)
+}
+
+func (o *OidcAuthChecker) CanDeployToDeployment(d *db.Deployment) bool {
+	return o.can(db.DeployAction, d)
+}
+
+func (o *OidcAuthChecker) CanDeleteDeployment(d *db.Deployment) bool {
+	return o.can(db.DeleteAction, d)
+}
+
+func (o *OidcAuthChecker) CanViewDeployment(d *db.Deployment) bool {
+	return o.can(db.ViewAction, d)
+}
+
+// any branch of the parent deployment's repo can create previews, since that's
+// the point of previews
+func (o *OidcAuthChecker) CanCreatePreview(parent *db.Deployment) bool {
+	if o.can(db.DeployAction, parent) {
+		return true
+	}
+	if parent.ExternalSourceType != o.sourceType() || len(o.claims.Repo) == 0 {
+		return false
+	}
+	repo, _, _ := strings.Cut(parent.ExternalSource, "#")
+	return repo == o.claims.Repo
+}
+
+func (o *OidcAuthChecker) CanCreateCredentials() bool {
+	return o.UserHasFullPermissions()
+}
+
+func (o *OidcAuthChecker) CanBootstrapToken() bool {
+	return false
+}
+
+func (o *OidcAuthChecker) CanManageServer() bool {
+	return o.UserHasFullPermissions()
+}
+
+func (o *OidcAuthChecker) Identity() string {
+	if len(o.claims.RunId) > 0 {
+		return strings.ToLower(o.issuer.Name) + ":" + o.claims.Actor +
+			" (" + o.claims.Repo + ", run " + o.claims.RunId + ")"
+	}
+	return strings.ToLower(o.issuer.Name) + ":" + o.claims.Actor + " (" + o.claims.Repo + ")"
+}
+
+// checks the token's signature and claims against the issuer that it says it's
+// from, and then pulls the claims that matter out of it
+func (c *oidcKeyCache) parseToken(token string, issuers []utils.OidcIssuer) (utils.OidcIssuer, oidcClaims, error) {
+	// the issuer can only be trusted after the token is verified, but it's
+	// needed to know which keys to verify the token with
+	unverified, err := jwt.ParseInsecure([]byte(token))
+	if err != nil {
+		return utils.OidcIssuer{}, oidcClaims{}, fmt.Errorf("%w: %v", ErrMalformedCredentials, err)
+	}
+	var issuer utils.OidcIssuer
+	found := false
+	for _, candidate := range issuers {
+		if candidate.Issuer == unverified.Issuer() {
+			issuer, found = candidate, true
+			break
+		}
+	}
+	if !found {
+		return utils.OidcIssuer{}, oidcClaims{}, fmt.Errorf(
+			"%w: tokens from %s aren't trusted", ErrInvalidCredentials, unverified.Issuer(),
+		)
+	}
+
+	start := time.Now()
+	claims, err := c.verify(token, issuer)
+	result := "valid"
+	if errors.Is(err, ErrInvalidCredentials) {
+		result = "invalid"
+	} else if err != nil {
+		result = "error"
+	}
+	c.metrics.oidcVerificationSeconds.WithLabelValues(issuer.Name, result).Observe(time.Since(start).Seconds())
+	if err != nil {
+		return utils.OidcIssuer{}, oidcClaims{}, err
+	}
+	return issuer, claims, nil
+}
+
+func (c *oidcKeyCache) verify(token string, issuer utils.OidcIssuer) (oidcClaims, error) {
+	keyId := ""
+	if message, err := jws.Parse([]byte(token)); err == nil && len(message.Signatures()) > 0 {
+		keyId = message.Signatures()[0].ProtectedHeaders().KeyID()
+	}
+	keySet, keySetErr := c.keysFor(issuer, keyId)
+	if keySetErr != nil {
+		// this isn't the client's fault, so it isn't an AuthError
+		return oidcClaims{}, keySetErr
+	}
+
+	verified, err := jwt.ParseString(token,
+		jwt.WithKeySet(keySet),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(issuer.Issuer),
+		jwt.WithAudience(issuer.Audience),
+	)
+	if err != nil {
+		return oidcClaims{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	claims := oidcClaims{
+		Repo:    stringClaim(verified, issuer.RepoClaim),
+		Actor:   stringClaim(verified, issuer.ActorClaim),
+		ActorId: stringClaim(verified, issuer.ActorIdClaim),
+	}
+	if len(issuer.RunIdClaim) > 0 {
+		claims.RunId = stringClaim(verified, issuer.RunIdClaim)
+	}
+	if len(claims.Repo) == 0 || len(claims.ActorId) == 0 {
+		return oidcClaims{}, fmt.Errorf(
+			"%w: token is missing the %s or %s claim", ErrInvalidCredentials,
+			issuer.RepoClaim, issuer.ActorIdClaim,
+		)
+	}
+	ref := stringClaim(verified, issuer.RefClaim)
+	if len(issuer.RefTypeClaim) == 0 || stringClaim(verified, issuer.RefTypeClaim) == "branch" {
+		// some issuers give full refs and some give just the branch name
+		if branch, isHead := strings.CutPrefix(ref, "refs/heads/"); isHead {
+			claims.Branch = branch
+		} else if !strings.HasPrefix(ref, "refs/") {
+			claims.Branch = ref
+		}
+	}
+
+	return claims, nil
+}
+
+// returns the claim as a string, even if it's a number in the token
+func stringClaim(token jwt.Token, name string) string {
+	value, ok := token.Get(name)
+	if !ok {
+		return ""
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
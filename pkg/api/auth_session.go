@@ -0,0 +1,175 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/internet-golf/internet-golf/pkg/db"
+	"github.com/internet-golf/internet-golf/pkg/utils"
+)
+
+// the cookie that admin dashboard sessions are kept in
+const SessionCookieName = "golf_session"
+
+// requests that use a session cookie have to send the session's csrf token in
+// this header, unless they're only reading things
+const CsrfHeaderName = "X-Golf-CSRF"
+
+var ErrSessionExpired = &AuthError{Status: http.StatusUnauthorized, Reason: "this session has expired; log in again"}
+var ErrInvalidCsrfToken = &AuthError{Status: http.StatusForbidden, Reason: "missing or invalid CSRF token"}
+
+// sessions are stored under a hash of their secret, so that someone who can
+// read the database still can't use them
+func hashSessionSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// the csrf token is worked out from the session's secret, so that it can be
+// checked without looking the session up. it's fine for the dashboard's
+// javascript to have it, since the secret can't be worked out from it
+func CsrfTokenForSession(secret string) string {
+	hash := sha256.Sum256([]byte("golf-csrf:" + secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// starts a session with the permissions of the bearer token. the token is
+// checked the same way that it would be in an auth header, including the
+// lockout for addresses that fail too often
+func (a *AuthManager) LoginWithToken(remoteAddr string, token string) (string, db.Session, error) {
+	ip := clientIp(remoteAddr)
+	if err := a.lockoutError(ip); err != nil {
+		return "", db.Session{}, err
+	}
+	checker := BearerTokenAuthChecker{Db: a.db}
+	applies, err := checker.setReqData(remoteAddr, "Bearer "+token)
+	if !applies {
+		err = ErrMalformedCredentials
+	}
+	if errors.Is(err, ErrInvalidCredentials) || errors.Is(err, ErrMalformedCredentials) {
+		a.failures.record(ip)
+	}
+	if err != nil {
+		return "", db.Session{}, err
+	}
+	a.failures.reset(ip)
+	return a.startSession(db.Session{
+		BearerTokenId:  checker.token.Id,
+		TokenRotatedAt: checker.token.RotatedAt,
+		Identity:       checker.Identity(),
+	})
+}
+
+// starts a session for a github user who has proven who they are some other
+// way, like with the oauth device flow. only registered users can log in
+func (a *AuthManager) LoginWithGithubUser(userId string, login string) (string, db.Session, error) {
+	if _, err := a.db.GetExternalUser(db.Github, userId); err != nil {
+		return "", db.Session{}, &AuthError{
+			Status: http.StatusForbidden, Reason: "Github user " + login + " hasn't been registered",
+		}
+	}
+	return a.startSession(db.Session{
+		ExternalSource: db.Github, ExternalId: userId, Identity: "github:" + login,
+	})
+}
+
+// returns the session's secret, which goes in the cookie
+func (a *AuthManager) startSession(session db.Session) (string, db.Session, error) {
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", db.Session{}, err
+	}
+	secret := hex.EncodeToString(secretBytes)
+	session.Id = hashSessionSecret(secret)
+	session.CreatedAt = time.Now()
+	session.ExpiresAt = session.CreatedAt.Add(a.config.SessionLifetime)
+	if err := a.db.SaveSession(session); err != nil {
+		return "", db.Session{}, err
+	}
+	return secret, session, nil
+}
+
+func (a *AuthManager) GetSession(secret string) (db.Session, error) {
+	return getSession(a.db, secret)
+}
+
+func (a *AuthManager) EndSession(secret string) error {
+	return a.db.DeleteSession(hashSessionSecret(secret))
+}
+
+func getSession(database db.Db, secret string) (db.Session, error) {
+	session, err := database.GetSession(hashSessionSecret(secret))
+	if err != nil {
+		return db.Session{}, ErrInvalidCredentials
+	}
+	if time.Now().After(session.ExpiresAt) {
+		database.DeleteSession(session.Id)
+		return db.Session{}, ErrSessionExpired
+	}
+	return session, nil
+}
+
+// provides authorization with admin dashboard sessions, whose cookies are
+// turned into "Session [secret]" auth headers by readAuth. everything besides
+// the session lookup is passed on to the checker for whatever was used to log
+// in. implements the Permissions interface
+type SessionAuthChecker struct {
+	Db db.Db
+	Permissions
+	session db.Session
+}
+
+func (s *SessionAuthChecker) setReqData(remoteAddr string, authHeader string) (bool, error) {
+	secret, isSession := strings.CutPrefix(authHeader, "Session ")
+	if !isSession {
+		return false, nil
+	}
+	session, err := getSession(s.Db, secret)
+	if err != nil {
+		return true, err
+	}
+	s.session = session
+
+	if len(session.BearerTokenId) > 0 {
+		token, err := s.Db.GetBearerToken(session.BearerTokenId)
+		if err != nil {
+			return true, ErrInvalidCredentials
+		}
+		if !token.RotatedAt.Equal(session.TokenRotatedAt) {
+			s.Db.DeleteSession(session.Id)
+			return true, &AuthError{
+				Status: http.StatusUnauthorized, Reason: "the token that this session was started with has been rotated; log in again",
+			}
+		}
+		tokenChecker := &BearerTokenAuthChecker{Db: s.Db, token: token}
+		if err := tokenChecker.checkUsable(); err != nil {
+			return true, err
+		}
+		tokenChecker.recordUse()
+		s.Permissions = tokenChecker
+		return true, nil
+	}
+
+	if _, err := s.Db.GetExternalUser(session.ExternalSource, session.ExternalId); err != nil {
+		return true, &AuthError{
+			Status: http.StatusUnauthorized, Reason: "the user that this session is for isn't registered anymore",
+		}
+	}
+	// the user didn't come from a ci workflow, so they don't have a repo and
+	// only get the permissions that they were registered with
+	s.Permissions = &OidcAuthChecker{
+		Db:     s.Db,
+		issuer: utils.OidcIssuer{Name: string(session.ExternalSource)},
+		claims: oidcClaims{Actor: session.Identity, ActorId: session.ExternalId},
+	}
+	return true, nil
+}
+
+func (s *SessionAuthChecker) Identity() string {
+	return s.session.Identity + " (dashboard session)"
+}
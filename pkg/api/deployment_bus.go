@@ -2,11 +2,16 @@ package api
 
 import (
 	_ "embed"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/internet-golf/internet-golf/pkg/db"
@@ -29,101 +34,258 @@ func urlFromString(url string) db.Url {
 // the DeploymentBus handles data and config received by the admin API and
 // persists them and turns them into websites.
 type DeploymentBus struct {
-	deployments []db.Deployment
-	server      public.PublicWebServer
-	db          db.Db
-	files       *resources.FileManager
+	config *utils.Config
+	// the current deployments. the slice that this points to is never
+	// modified; changes are made to a copy of it by update, which then
+	// replaces it, so it can be read without locking anything
+	deployments atomic.Pointer[[]db.Deployment]
+	// held while a change to the deployments is being made, so that only one
+	// happens at a time
+	updating   sync.Mutex
+	server     public.PublicWebServer
+	db         db.Db
+	files      *resources.FileManager
+	containers *resources.ContainerManager
+	processes  *resources.ProcessManager
+	garbage    *resources.GarbageCollector
+	blobs      *resources.BlobStore
+	// closed to stop the background tasks (garbage collection and deleting
+	// expired previews)
+	stop chan struct{}
 }
 
-func NewDeploymentBus(server public.PublicWebServer, db db.Db, files *resources.FileManager) (*DeploymentBus, error) {
-	deployments, err := db.GetDeployments()
+// how often the bus checks for preview deployments that have expired
+const previewExpiryCheckInterval = time.Minute
+
+// how long preview deployments last if their parent deployment doesn't say
+const DefaultPreviewTTL = 7 * 24 * time.Hour
+
+// preview deployments are at domains like "[label].[parent's PreviewDomain]",
+// where the label has to be a valid DNS label
+var previewLabel = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// a deployment's previews have to be under its own domain, so that it can't be
+// used to create previews at someone else's domain
+func validatePreviewDomain(url db.Url, previewDomain string) error {
+	if previewDomain != url.Domain && !strings.HasSuffix(previewDomain, "."+url.Domain) {
+		return fmt.Errorf("the preview domain has to be %s or a subdomain of it", url.Domain)
+	}
+	return nil
+}
+
+func NewDeploymentBus(
+	config *utils.Config, server public.PublicWebServer, database db.Db,
+	files *resources.FileManager,
+	containers *resources.ContainerManager, processes *resources.ProcessManager,
+) (*DeploymentBus, error) {
+	deployments, err := database.GetDeployments()
 	if err != nil {
 		return nil, err
 	}
 
+	// containers and processes don't survive server restarts (or at least,
+	// their ports don't), so they need to be recreated
+	for i, d := range deployments {
+		if d.HasContent && d.ServedThingType == db.NativeProcess {
+			hostAddress, err := processes.Start(d.Url.String(), d.ProcessExecutable)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "could not restart process for %s: %v\n", d.Url, err)
+				continue
+			}
+			deployments[i].ServedThing = hostAddress
+		}
+		if d.HasContent && d.ServedThingType == db.DockerContainer {
+			hostAddress, err := containers.Start(d.Url.String(), resources.ContainerSpec{
+				Image:        d.ContainerImage,
+				InternalPort: d.ContainerPort,
+			}, true)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "could not restart container for %s: %v\n", d.Url, err)
+				continue
+			}
+			deployments[i].ServedThing = hostAddress
+		}
+	}
+
 	if err := server.DeployAll(deployments); err != nil {
 		return nil, err
 	}
 
-	return &DeploymentBus{
-		deployments: deployments,
-		server:      server,
-		db:          db,
-		files:       files,
-	}, nil
+	bus := &DeploymentBus{
+		config:     config,
+		server:     server,
+		db:         database,
+		files:      files,
+		containers: containers,
+		processes:  processes,
+		garbage:    resources.NewGarbageCollector(files, config.GcGracePeriod),
+		blobs:      resources.NewBlobStore(files),
+		stop:       make(chan struct{}),
+	}
+	bus.deployments.Store(&deployments)
 
+	if config.GcInterval > 0 {
+		go bus.collectGarbagePeriodically(config.GcInterval)
+	}
+	go bus.deleteExpiredPreviewsPeriodically(previewExpiryCheckInterval)
+
+	return bus, nil
 }
 
 func (bus *DeploymentBus) Stop() error {
+	close(bus.stop)
+	bus.containers.StopAll()
+	bus.processes.StopAll()
 	return bus.server.Stop()
 }
 
-func (bus *DeploymentBus) persistDeployments() error {
-	return bus.db.SaveDeployments(bus.deployments)
+// returns the current deployments. other goroutines might be reading the
+// same slice, so it (and the slices in the deployments) must not be modified
+func (bus *DeploymentBus) Deployments() []db.Deployment {
+	return *bus.deployments.Load()
+}
+
+// makes a change to the deployments. change gets a copy of the current
+// deployments, which it can modify (but not the slices inside of them, which
+// are shared with the current deployments), and returns what the deployments
+// should be now. those are pushed to the public web server and saved, and
+// then they become the current deployments. only one change is made at a
+// time; if change or the public web server fail, the current deployments stay
+// the same and the public web server goes back to serving them
+func (bus *DeploymentBus) update(change func(deployments []db.Deployment) ([]db.Deployment, error)) error {
+	bus.updating.Lock()
+	defer bus.updating.Unlock()
+
+	current := bus.Deployments()
+	next, err := change(slices.Clone(current))
+	if err != nil {
+		return err
+	}
+
+	if err := bus.server.DeployAll(next); err != nil {
+		// the public web server might have been partly updated
+		if rollbackErr := bus.server.DeployAll(current); rollbackErr != nil {
+			fmt.Fprintf(os.Stderr, "could not go back to the previous deployments: %v\n", rollbackErr)
+		}
+		return err
+	}
+
+	// the new deployments are already being served, so they're the current
+	// ones even if they can't be saved
+	bus.deployments.Store(&next)
+	return bus.save(current, next)
+}
+
+// returned (wrapped) when a change to the deployments was made but couldn't be
+// saved, which means that it'll be lost when the server restarts
+var ErrDeploymentsNotSaved = errors.New("could not save deployments")
+
+// saves the deployments and deletes the ones that were in previous but aren't
+// in current anymore
+func (bus *DeploymentBus) save(previous []db.Deployment, current []db.Deployment) error {
+	for _, d := range previous {
+		if getDeploymentIndexByUrl(current, &d.Url) != -1 {
+			continue
+		}
+		if err := bus.db.DeleteDeployment(d.Url); err != nil {
+			return fmt.Errorf("%w: %w", ErrDeploymentsNotSaved, err)
+		}
+	}
+	if err := bus.db.SaveDeployments(current); err != nil {
+		return fmt.Errorf("%w: %w", ErrDeploymentsNotSaved, err)
+	}
+	return nil
 }
 
 // create a deployment or, if a deployment with the same name as the input
 // metadata already exists, update its metadata
 func (bus *DeploymentBus) SetupDeployment(metadata db.DeploymentMetadata) error {
+	return bus.update(func(deployments []db.Deployment) ([]db.Deployment, error) {
+		return setupDeployment(deployments, metadata)
+	})
+}
+
+// the part of SetupDeployment that happens inside of bus.update, so that other
+// changes can check things against the same set of deployments first
+func setupDeployment(deployments []db.Deployment, metadata db.DeploymentMetadata) ([]db.Deployment, error) {
 	// TODO: make sure its URL does not overlap with any existing deployments
 	// (except the one it is replacing), and that at least the domain is present
 	// and a valid domain name? also validate externalSourceType if that's a thing
 
-	existingIndex := slices.IndexFunc(bus.deployments, func(d db.Deployment) bool {
-		return d.Url.Equals(&metadata.Url)
-	})
+	// the public web server would just leave out a deployment with bad rules,
+	// so they have to be caught here
+	for _, rule := range metadata.HeaderRules {
+		if err := public.ValidateHeaderRule(rule); err != nil {
+			return nil, err
+		}
+	}
 
+	if len(metadata.PreviewDomain) > 0 {
+		if err := validatePreviewDomain(metadata.Url, metadata.PreviewDomain); err != nil {
+			return nil, err
+		}
+		for _, d := range deployments {
+			if d.PreviewDomain == metadata.PreviewDomain && !d.Url.Equals(&metadata.Url) {
+				return nil, fmt.Errorf("%s already has previews under %s", d.Url, d.PreviewDomain)
+			}
+		}
+	}
+
+	existingIndex := getDeploymentIndexByUrl(deployments, &metadata.Url)
 	if existingIndex == -1 {
 		metadata.CreatedAt = time.Now()
-		bus.deployments = append(bus.deployments, db.Deployment{DeploymentMetadata: metadata})
-	} else {
-		metadata.UpdatedAt = time.Now()
-		bus.deployments[existingIndex].DeploymentMetadata = metadata
+		return append(deployments, db.Deployment{DeploymentMetadata: metadata}), nil
 	}
-
-	deploymentErr := bus.server.DeployAll(bus.deployments)
-	if deploymentErr != nil {
-		// rollback to persisted state?
-		return deploymentErr
+	existing := deployments[existingIndex].DeploymentMetadata
+	metadata.CreatedAt = existing.CreatedAt
+	metadata.UpdatedAt = time.Now()
+	// these are only set by SetupPreviewDeployment, so changing a
+	// preview's settings some other way shouldn't make it permanent
+	if len(metadata.PreviewOf.Domain) == 0 {
+		metadata.PreviewOf = existing.PreviewOf
 	}
-
-	return bus.persistDeployments()
+	if metadata.ExpiresAt.IsZero() {
+		metadata.ExpiresAt = existing.ExpiresAt
+	}
+	deployments[existingIndex].DeploymentMetadata = metadata
+	return deployments, nil
 }
 
 // TODO: method to change the URL of a deployment?
 
-func (bus *DeploymentBus) getDeploymentIndexByUrl(url *db.Url) int {
-	return slices.IndexFunc(bus.deployments, func(d db.Deployment) bool {
+func getDeploymentIndexByUrl(deployments []db.Deployment, url *db.Url) int {
+	return slices.IndexFunc(deployments, func(d db.Deployment) bool {
 		return d.Url.Equals(url)
 	})
 }
 
 func (bus *DeploymentBus) GetDeploymentByUrl(url *db.Url) (db.Deployment, error) {
-	index := bus.getDeploymentIndexByUrl(url)
+	deployments := bus.Deployments()
+	index := getDeploymentIndexByUrl(deployments, url)
 	if index == -1 {
 		return db.Deployment{}, fmt.Errorf("Deployment with URL \"%s\" not found", url)
 	}
-	return bus.deployments[index], nil
+	return deployments[index], nil
 }
 
 func (bus *DeploymentBus) PutDeploymentContentByUrl(
 	url db.Url, content db.DeploymentContent,
 ) error {
-	existingIndex := bus.getDeploymentIndexByUrl(&url)
-	if existingIndex == -1 {
-		return fmt.Errorf(
-			"Could not find deployment with url \"%s\" to update content", url,
-		)
-	}
-	return bus.updateDeploymentContentByIndex(existingIndex, content)
+	return bus.updateDeploymentContent(url, func(deployment *db.Deployment) (db.DeploymentContent, error) {
+		return content, nil
+	})
 }
 
+// extracts the uploaded files, points the deployment at them, and records them
+// as a new revision of the deployment's content. uploadedBy is a description of
+// who uploaded them
 func (bus *DeploymentBus) PutStaticFilesForDeployment(
-	deployment db.Deployment, gzippedDir io.ReadSeeker, keepLeadingDirectories bool,
+	deployment db.Deployment, archive io.Reader, keepLeadingDirectories bool,
+	uploadedBy string,
 ) error {
 
-	outDir, extractionErr := bus.files.TarGzToDeploymentFiles(
-		gzippedDir, deployment.Url.String(),
+	files, extractionErr := bus.files.ArchiveToDeploymentFiles(
+		archive, deployment.Url.String(),
 		keepLeadingDirectories,
 	)
 
@@ -131,16 +293,469 @@ func (bus *DeploymentBus) PutStaticFilesForDeployment(
 		return extractionErr
 	}
 
-	bus.PutDeploymentContentByUrl(deployment.Url, db.DeploymentContent{
+	return bus.putDeploymentFiles(deployment, files, uploadedBy)
+}
+
+// like PutStaticFilesForDeployment, except the uploaded files are added to the
+// deployment's current files instead of replacing them, and the paths in
+// deletions are removed from the current files first. if the deployment
+// doesn't have any files yet, this is the same as PutStaticFilesForDeployment
+func (bus *DeploymentBus) PatchStaticFilesForDeployment(
+	deployment db.Deployment, archive io.Reader, keepLeadingDirectories bool,
+	deletions []string, uploadedBy string,
+) error {
+	if deployment.ServedThingType != db.StaticFiles || len(deployment.ServedThing) == 0 {
+		return bus.PutStaticFilesForDeployment(deployment, archive, keepLeadingDirectories, uploadedBy)
+	}
+
+	files, extractionErr := bus.files.ArchiveOverDeploymentFiles(
+		archive, deployment.Url.String(), keepLeadingDirectories,
+		deployment.ServedThing, deletions,
+	)
+	if extractionErr != nil {
+		return extractionErr
+	}
+
+	return bus.putDeploymentFiles(deployment, files, uploadedBy)
+}
+
+// returns the hashes of the files in the manifest that haven't been uploaded
+// for the deployment yet
+func (bus *DeploymentBus) MissingBlobs(
+	deployment db.Deployment, manifest []resources.ManifestEntry,
+) ([]string, error) {
+	if err := resources.ValidateManifest(manifest); err != nil {
+		return nil, err
+	}
+	hashes := []string{}
+	for _, entry := range manifest {
+		hashes = append(hashes, entry.Hash)
+	}
+	return bus.blobs.Missing(hashes, deployment.Url.String()), nil
+}
+
+// stores an uploaded file so that it can be used in the deployment's
+// manifests. returns its hash
+func (bus *DeploymentBus) PutBlob(deployment db.Deployment, blob io.Reader) (string, error) {
+	return bus.blobs.Put(blob, deployment.Url.String())
+}
+
+// points the deployment at a directory made of the files in the manifest,
+// which have to have been uploaded already, and records that as a new revision
+// of the deployment's content
+func (bus *DeploymentBus) PutManifestForDeployment(
+	deployment db.Deployment, manifest []resources.ManifestEntry, uploadedBy string,
+) error {
+	files, err := bus.blobs.ManifestToDeploymentFiles(manifest, deployment.Url.String())
+	if err != nil {
+		return err
+	}
+	return bus.putDeploymentFiles(deployment, files, uploadedBy)
+}
+
+func (bus *DeploymentBus) putDeploymentFiles(
+	deployment db.Deployment, files resources.DeploymentFiles, uploadedBy string,
+) error {
+	// the directory that was being used before this will be deleted by the
+	// garbage collector once no revision refers to it anymore
+
+	content := db.DeploymentContent{
 		HasContent:      true,
 		ServedThingType: db.StaticFiles,
-		ServedThing:     outDir,
+		ServedThing:     files.Path,
+	}
+	content.Redirects, content.FileHeaderRules, content.SiteConfigErrors =
+		public.ParseSiteConfigFiles(files.Path)
+
+	return bus.updateDeploymentContent(deployment.Url, func(d *db.Deployment) (db.DeploymentContent, error) {
+		bus.addRevision(d, db.ContentRevision{
+			Hash:       files.Hash,
+			Path:       files.Path,
+			CreatedAt:  time.Now(),
+			UploadedBy: uploadedBy,
+			Size:       files.Size,
+		})
+		return content, nil
 	})
+}
 
-	// TODO: delete the old directory after deployContent is
-	// finished? presumably that'll be safe (INT-42)
+// adds the revision to the end of the deployment's list of revisions (or moves
+// it there, if the same content was uploaded before) and then forgets about the
+// oldest revisions if there are more than the config says to keep
+func (bus *DeploymentBus) addRevision(deployment *db.Deployment, revision db.ContentRevision) {
+	// the list is copied first, since the current deployments are still using
+	// it
+	deployment.Revisions = slices.DeleteFunc(slices.Clone(deployment.Revisions), func(r db.ContentRevision) bool {
+		return r.Hash == revision.Hash
+	})
+	deployment.Revisions = append(deployment.Revisions, revision)
 
-	return nil
+	keep := bus.config.RevisionsToKeep
+	if keep > 0 && len(deployment.Revisions) > keep {
+		deployment.Revisions = slices.Clone(deployment.Revisions[len(deployment.Revisions)-keep:])
+	}
+}
+
+// re-points the deployment at the files from one of its earlier revisions. the
+// revision can be identified by its hash or by a unique prefix of it; if it's
+// empty, the revision before the currently active one is used
+func (bus *DeploymentBus) RollbackDeployment(url db.Url, revision string) (db.ContentRevision, error) {
+	var target db.ContentRevision
+	err := bus.updateDeploymentContent(url, func(deployment *db.Deployment) (db.DeploymentContent, error) {
+		var err error
+		target, err = findRollbackTarget(*deployment, revision)
+		if err != nil {
+			return db.DeploymentContent{}, err
+		}
+		// keep everything else about the content the same (like SpaMode),
+		// except for what comes from the files themselves
+		content := deployment.DeploymentContent
+		content.ServedThing = target.Path
+		content.Redirects, content.FileHeaderRules, content.SiteConfigErrors =
+			public.ParseSiteConfigFiles(target.Path)
+		return content, nil
+	})
+	if err != nil {
+		return db.ContentRevision{}, err
+	}
+	return target, nil
+}
+
+// finds the revision that RollbackDeployment should go back to
+func findRollbackTarget(deployment db.Deployment, revision string) (db.ContentRevision, error) {
+	url := deployment.Url
+	if deployment.ServedThingType != db.StaticFiles || len(deployment.Revisions) == 0 {
+		return db.ContentRevision{}, fmt.Errorf("deployment %s has no revisions to roll back to", url)
+	}
+
+	activeIndex := slices.IndexFunc(deployment.Revisions, func(r db.ContentRevision) bool {
+		return r.Path == deployment.ServedThing
+	})
+
+	targetIndex := -1
+	if len(revision) == 0 {
+		if activeIndex <= 0 {
+			return db.ContentRevision{}, fmt.Errorf("deployment %s has no earlier revision to roll back to", url)
+		}
+		targetIndex = activeIndex - 1
+	} else {
+		for i, r := range deployment.Revisions {
+			if strings.HasPrefix(r.Hash, revision) {
+				if targetIndex != -1 {
+					return db.ContentRevision{}, fmt.Errorf("revision \"%s\" is ambiguous", revision)
+				}
+				targetIndex = i
+			}
+		}
+		if targetIndex == -1 {
+			return db.ContentRevision{}, fmt.Errorf("could not find revision \"%s\" for %s", revision, url)
+		}
+	}
+
+	target := deployment.Revisions[targetIndex]
+	if _, err := os.Stat(target.Path); err != nil {
+		return db.ContentRevision{}, fmt.Errorf("files for revision %s are missing: %w", target.Hash, err)
+	}
+	return target, nil
+}
+
+// starts a docker container for the deployment and points the deployment at
+// it. if imageArchive is not nil, the image is loaded from it; otherwise, the
+// image is pulled from its registry. the container that the deployment was
+// using before is only stopped once the deployment has switched to the new one
+func (bus *DeploymentBus) PutContainerForDeployment(
+	deployment db.Deployment, image string, imageArchive io.Reader, internalPort int,
+) error {
+	contentName := deployment.Url.String()
+	hostAddress, err := bus.containers.Start(contentName, resources.ContainerSpec{
+		Image:        image,
+		InternalPort: internalPort,
+		ImageArchive: imageArchive,
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	err = bus.PutDeploymentContentByUrl(deployment.Url, db.DeploymentContent{
+		HasContent:      true,
+		ServedThingType: db.DockerContainer,
+		ServedThing:     hostAddress,
+		ContainerImage:  image,
+		ContainerPort:   internalPort,
+	})
+	if err != nil && !errors.Is(err, ErrDeploymentsNotSaved) {
+		// the deployment is still using whatever it was using before
+		if discardErr := bus.containers.Discard(contentName, hostAddress); discardErr != nil {
+			fmt.Fprintf(os.Stderr, "could not remove unused container for %s: %v\n", contentName, discardErr)
+		}
+		return err
+	}
+
+	if stopErr := bus.containers.StopPrevious(contentName, hostAddress); stopErr != nil {
+		fmt.Fprintf(os.Stderr, "could not stop previous containers for %s: %v\n", contentName, stopErr)
+	}
+	return err
+}
+
+// stores the uploaded executable (or archive containing an executable at the
+// path entrypoint) for the deployment, starts it, and points the deployment at
+// it
+func (bus *DeploymentBus) PutProcessForDeployment(
+	deployment db.Deployment, upload io.Reader, entrypoint string,
+) error {
+	executable, err := bus.files.ExecutableToDeploymentFiles(
+		upload, deployment.Url.String(), entrypoint,
+	)
+	if err != nil {
+		return err
+	}
+
+	contentName := deployment.Url.String()
+	hostAddress, err := bus.processes.Start(contentName, executable)
+	if err != nil {
+		return err
+	}
+
+	err = bus.PutDeploymentContentByUrl(deployment.Url, db.DeploymentContent{
+		HasContent:        true,
+		ServedThingType:   db.NativeProcess,
+		ServedThing:       hostAddress,
+		ProcessExecutable: executable,
+	})
+	if err != nil && !errors.Is(err, ErrDeploymentsNotSaved) {
+		// same as with containers: the old process is still in use
+		bus.processes.Discard(contentName, hostAddress)
+		return err
+	}
+
+	if stopErr := bus.processes.StopPrevious(contentName, hostAddress); stopErr != nil {
+		fmt.Fprintf(os.Stderr, "could not stop previous processes for %s: %v\n", contentName, stopErr)
+	}
+	return err
+}
+
+// returns the recent output of the process that is running for the deployment
+func (bus *DeploymentBus) GetProcessLogs(deployment db.Deployment) ([]byte, error) {
+	if deployment.ServedThingType != db.NativeProcess {
+		return nil, fmt.Errorf("deployment %s is not running a process", deployment.Url)
+	}
+	return bus.processes.Logs(deployment.Url.String())
+}
+
+// points the deployment at one or more existing servers. only the Proxy* fields
+// of proxy are used
+func (bus *DeploymentBus) PutReverseProxyDeployment(url db.Url, proxy db.DeploymentContent) error {
+	if len(proxy.ProxyUpstreams) == 0 {
+		return fmt.Errorf("at least one upstream is required")
+	}
+	for _, upstream := range proxy.ProxyUpstreams {
+		if _, _, err := net.SplitHostPort(upstream); err != nil {
+			return fmt.Errorf("upstream \"%s\" is not in host:port format", upstream)
+		}
+	}
+	for name := range proxy.ProxyHeaders {
+		// the upstream relies on these to know where the request came from
+		// (the admin api trusts X-Forwarded-For from caddy, for one)
+		lower := strings.ToLower(name)
+		if lower == "host" || strings.HasPrefix(lower, "x-forwarded-") {
+			return fmt.Errorf("the %s header can't be overridden", name)
+		}
+	}
+	if len(proxy.ProxyHealthCheckInterval) > 0 {
+		if _, err := time.ParseDuration(proxy.ProxyHealthCheckInterval); err != nil {
+			return fmt.Errorf("invalid health check interval: %w", err)
+		}
+	}
+
+	return bus.PutDeploymentContentByUrl(url, db.DeploymentContent{
+		HasContent:               true,
+		ServedThingType:          db.ReverseProxy,
+		ServedThing:              proxy.ProxyUpstreams[0],
+		ProxyUpstreams:           proxy.ProxyUpstreams,
+		ProxyLoadBalancing:       proxy.ProxyLoadBalancing,
+		ProxyHealthCheckPath:     proxy.ProxyHealthCheckPath,
+		ProxyHealthCheckInterval: proxy.ProxyHealthCheckInterval,
+		ProxyHeaders:             proxy.ProxyHeaders,
+	})
+}
+
+// returns the paths on disk that deployments are currently using or might use
+// again (through a rollback)
+func (bus *DeploymentBus) referencedPaths() []string {
+	paths := []string{}
+	for _, d := range bus.Deployments() {
+		switch d.ServedThingType {
+		case db.StaticFiles:
+			paths = append(paths, d.ServedThing)
+		case db.NativeProcess:
+			paths = append(paths, d.ProcessExecutable)
+		}
+		for _, r := range d.Revisions {
+			paths = append(paths, r.Path)
+		}
+	}
+	return paths
+}
+
+// deletes content directories that no deployment or revision uses anymore
+// (once they've been unused for the grace period.) if dryRun is true, this just
+// reports what would be deleted
+func (bus *DeploymentBus) CollectGarbage(dryRun bool) (resources.GarbageReport, error) {
+	return bus.garbage.Collect(bus.referencedPaths(), dryRun)
+}
+
+func (bus *DeploymentBus) collectGarbagePeriodically(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-bus.stop:
+			return
+		case <-ticker.C:
+			report, err := bus.CollectGarbage(false)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "garbage collection failed: %v\n", err)
+			} else if len(report.Deleted) > 0 {
+				fmt.Printf("garbage collection deleted %d directories\n", len(report.Deleted))
+			}
+		}
+	}
+}
+
+// finds the deployment whose PreviewDomain the url is a preview under. the url
+// has to be in the form "[label].[preview domain]", with no path
+func (bus *DeploymentBus) FindPreviewParent(url db.Url) (db.Deployment, error) {
+	if len(url.Path) > 0 && url.Path != "/" {
+		return db.Deployment{}, fmt.Errorf("preview URLs cannot have a path")
+	}
+	label, previewDomain, found := strings.Cut(url.Domain, ".")
+	if !found || !previewLabel.MatchString(label) {
+		return db.Deployment{}, fmt.Errorf(
+			"\"%s\" is not a valid preview URL; expected something like \"pr-123.preview.example.com\"", url.Domain,
+		)
+	}
+	// SetupDeployment makes sure that this can only match one deployment, but
+	// deployments saved before it checked might not have valid preview domains
+	parents := []db.Deployment{}
+	for _, d := range bus.Deployments() {
+		if len(d.PreviewDomain) > 0 && d.PreviewDomain == previewDomain &&
+			validatePreviewDomain(d.Url, d.PreviewDomain) == nil {
+			parents = append(parents, d)
+		}
+	}
+	if len(parents) == 0 {
+		return db.Deployment{}, fmt.Errorf("no deployment allows previews under %s", previewDomain)
+	}
+	if len(parents) > 1 {
+		return db.Deployment{}, fmt.Errorf("more than one deployment allows previews under %s", previewDomain)
+	}
+	return parents[0], nil
+}
+
+// creates a preview deployment of parent at url, or, if that preview already
+// exists, pushes back its expiry time. the preview belongs to the parent's
+// repo (without a specific branch) so that any branch can deploy to it
+func (bus *DeploymentBus) SetupPreviewDeployment(parent db.Deployment, url db.Url) (db.Deployment, error) {
+	ttl := parent.PreviewTTL
+	if ttl <= 0 {
+		ttl = DefaultPreviewTTL
+	}
+	repo, _, _ := strings.Cut(parent.ExternalSource, "#")
+
+	if err := bus.update(func(deployments []db.Deployment) ([]db.Deployment, error) {
+		// this is checked here so that nothing else can take the url between
+		// checking it and creating the preview
+		if index := getDeploymentIndexByUrl(deployments, &url); index != -1 &&
+			!deployments[index].PreviewOf.Equals(&parent.Url) {
+			return nil, fmt.Errorf("%s already exists and is not a preview of %s", url, parent.Url)
+		}
+		return setupDeployment(deployments, db.DeploymentMetadata{
+			Url:                url,
+			ExternalSource:     repo,
+			ExternalSourceType: parent.ExternalSourceType,
+			Name:               parent.Name,
+			Tags:               []string{"preview"},
+			SecurityHeaders:    parent.SecurityHeaders,
+			HeaderRules:        parent.HeaderRules,
+			ErrorPages:         parent.ErrorPages,
+			AccessControl:      parent.AccessControl,
+			PreviewOf:          parent.Url,
+			ExpiresAt:          time.Now().Add(ttl),
+		})
+	}); err != nil {
+		return db.Deployment{}, err
+	}
+	return bus.GetDeploymentByUrl(&url)
+}
+
+// deletes the preview deployments whose expiry time has passed and returns
+// their urls
+func (bus *DeploymentBus) DeleteExpiredPreviews() []db.Url {
+	var expired []db.Url
+	var deleted []db.Deployment
+	err := bus.update(func(deployments []db.Deployment) ([]db.Deployment, error) {
+		// the expiry times are checked against the current deployments, so a
+		// preview that was just redeployed (which pushes its expiry time back)
+		// is left alone
+		expired = []db.Url{}
+		deleted = []db.Deployment{}
+		now := time.Now()
+		for _, d := range deployments {
+			if !d.ExpiresAt.IsZero() && now.After(d.ExpiresAt) {
+				expired = append(expired, d.Url)
+			}
+		}
+		for _, url := range expired {
+			// previews of an expired deployment are deleted along with it
+			if getDeploymentIndexByUrl(deployments, &url) == -1 {
+				continue
+			}
+			var deletedNow []db.Deployment
+			var err error
+			deployments, deletedNow, err = deleteDeployment(deployments, url)
+			if err != nil {
+				return nil, err
+			}
+			deleted = append(deleted, deletedNow...)
+		}
+		return deployments, nil
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "could not delete expired previews: %v\n", err)
+		return []db.Url{}
+	}
+	bus.cleanUpDeleted(deleted)
+	return expired
+}
+
+func (bus *DeploymentBus) deleteExpiredPreviewsPeriodically(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-bus.stop:
+			return
+		case <-ticker.C:
+			for _, url := range bus.DeleteExpiredPreviews() {
+				fmt.Printf("deleted expired preview %s\n", url)
+			}
+		}
+	}
+}
+
+// stops whatever the deployment had running in the background (like a
+// container or process) to serve its content. this should be called after the
+// deployment has been removed from the public web server, or after it's been
+// changed to not need that thing anymore
+func (bus *DeploymentBus) stopServedThing(url db.Url, servedThingType db.ServedThingType) {
+	switch servedThingType {
+	case db.DockerContainer:
+		if err := bus.containers.Stop(url.String()); err != nil {
+			fmt.Fprintf(os.Stderr, "could not stop container for %s: %v\n", url, err)
+		}
+	case db.NativeProcess:
+		bus.processes.Stop(url.String())
+	}
 }
 
 func (bus *DeploymentBus) PutAdminDash(url db.Url) error {
@@ -173,62 +788,136 @@ func (bus *DeploymentBus) PutAliasDeployment(from db.Url, to db.Url, redirect bo
 	})
 }
 
-// updates the content of the deployment at the given index, pushes the
-// deployments to the public web server, and then saves them
-func (bus *DeploymentBus) updateDeploymentContentByIndex(
-	index int, content db.DeploymentContent,
+// changes the content of the deployment at the url to what newContent returns,
+// pushes the deployments to the public web server, and then saves them.
+// newContent gets the deployment as it is when the change is made, and can
+// also change other things about it (as long as it copies any slices that it
+// modifies)
+func (bus *DeploymentBus) updateDeploymentContent(
+	url db.Url, newContent func(deployment *db.Deployment) (db.DeploymentContent, error),
 ) error {
-	deployment := &bus.deployments[index]
-	deployment.DeploymentContent = content
-	deployment.DeploymentContent.HasContent = true
-	deployment.DeploymentMetadata.UpdatedAt = time.Now()
-
-	deploymentErr := bus.server.DeployAll(bus.deployments)
-	if deploymentErr != nil {
-		// rollback to previous persisted state?
-		return deploymentErr
-	}
-
-	go func() {
-		if len(deployment.Url.Domain) == 0 {
-			return
+	var previousType, newType db.ServedThingType
+	err := bus.update(func(deployments []db.Deployment) ([]db.Deployment, error) {
+		index := getDeploymentIndexByUrl(deployments, &url)
+		if index == -1 {
+			return nil, fmt.Errorf(
+				"Could not find deployment with url \"%s\" to update content", url,
+			)
 		}
-		// wait for deployment to finish deploying, just in case
-		time.Sleep(3 * time.Second)
-		meta, err := utils.GetMetaInfo("http://" + deployment.Url.String())
+		deployment := &deployments[index]
+		content, err := newContent(deployment)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "error fetching meta info: %v\n", err.Error())
-			return
+			return nil, err
 		}
-		deployment.MetaInfo = *meta
-		bus.persistDeployments()
-	}()
+		previousType, newType = deployment.ServedThingType, content.ServedThingType
+		deployment.DeploymentContent = content
+		deployment.DeploymentContent.HasContent = true
+		deployment.DeploymentMetadata.UpdatedAt = time.Now()
+		return deployments, nil
+	})
+	if err != nil {
+		return err
+	}
 
-	return bus.persistDeployments()
+	// if this deployment used to be a container or process and now it's
+	// something else, that thing isn't needed anymore
+	if previousType != newType {
+		bus.stopServedThing(url, previousType)
+	}
+
+	go bus.fetchMetaInfo(url)
+
+	return nil
+}
+
+// gets the title and so on of the page at the deployment's url and saves them
+// with the deployment
+func (bus *DeploymentBus) fetchMetaInfo(url db.Url) {
+	if len(url.Domain) == 0 {
+		return
+	}
+	// wait for deployment to finish deploying, just in case
+	select {
+	case <-bus.stop:
+		return
+	case <-time.After(3 * time.Second):
+	}
+	meta, err := utils.GetMetaInfo("http://" + url.String())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error fetching meta info: %v\n", err.Error())
+		return
+	}
+
+	// the meta info doesn't change what the public web server does, so this
+	// doesn't go through update, which would redeploy everything
+	bus.updating.Lock()
+	defer bus.updating.Unlock()
+	deployments := slices.Clone(bus.Deployments())
+	index := getDeploymentIndexByUrl(deployments, &url)
+	if index == -1 {
+		// it was deleted in the meantime
+		return
+	}
+	deployments[index].MetaInfo = *meta
+	bus.deployments.Store(&deployments)
+	if err := bus.db.SaveDeployments(deployments); err != nil {
+		fmt.Fprintf(os.Stderr, "could not save meta info for %s: %v\n", url, err)
+	}
 }
 
 // deletes the deployment from the given name, pushes the deployment set
 // (without the deleted one) to the public web server, and then saves the new
-// deployment set. also deletes any aliases that point to the deleted deployment.
+// deployment set. also deletes any aliases that point to the deleted deployment
+// and any previews of it.
 func (bus *DeploymentBus) DeleteDeployment(url db.Url) error {
-	index := bus.getDeploymentIndexByUrl(&url)
+	var deleted []db.Deployment
+	err := bus.update(func(deployments []db.Deployment) ([]db.Deployment, error) {
+		var err error
+		deployments, deleted, err = deleteDeployment(deployments, url)
+		return deployments, err
+	})
+	if err != nil {
+		return err
+	}
+	bus.cleanUpDeleted(deleted)
+	return nil
+}
+
+// the part of DeleteDeployment that happens inside of bus.update. returns the
+// remaining deployments and the ones that were deleted (not counting aliases,
+// which don't have anything to clean up)
+func deleteDeployment(
+	deployments []db.Deployment, url db.Url,
+) ([]db.Deployment, []db.Deployment, error) {
+	index := getDeploymentIndexByUrl(deployments, &url)
 	if index == -1 {
-		return fmt.Errorf("could not find deployment with URL \"%s\" to delete it", url)
+		return nil, nil, fmt.Errorf("could not find deployment with URL \"%s\" to delete it", url)
 	}
 
-	bus.deployments = slices.Delete(bus.deployments, index, index+1)
+	deleted := []db.Deployment{deployments[index]}
+	deployments = slices.Delete(deployments, index, index+1)
 
-	// delete any aliases that point to the deleted deployment
-	bus.deployments = slices.DeleteFunc(bus.deployments, func(d db.Deployment) bool {
-		return d.ServedThingType == db.Alias && d.AliasedTo.Equals(&url)
-	})
+	// delete any aliases that point to the deleted deployment and any
+	// previews of it
+	return slices.DeleteFunc(deployments, func(d db.Deployment) bool {
+		if d.ServedThingType == db.Alias && d.AliasedTo.Equals(&url) {
+			return true
+		}
+		if len(d.PreviewOf.Domain) > 0 && d.PreviewOf.Equals(&url) {
+			deleted = append(deleted, d)
+			return true
+		}
+		return false
+	}), deleted, nil
+}
 
-	deploymentErr := bus.server.DeployAll(bus.deployments)
-	if deploymentErr != nil {
-		return deploymentErr
+// now that nothing is being routed to the deleted deployments' containers or
+// processes, they can be stopped
+func (bus *DeploymentBus) cleanUpDeleted(deleted []db.Deployment) {
+	for _, d := range deleted {
+		bus.stopServedThing(d.Url, d.ServedThingType)
+		if err := bus.blobs.ForgetClaims(d.Url.String()); err != nil {
+			fmt.Fprintf(os.Stderr, "could not forget uploaded files for %s: %v\n", d.Url, err)
+		}
 	}
-
-	bus.persistDeployments()
-
-	return nil
 }
@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"slices"
@@ -10,18 +12,65 @@ import (
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/internet-golf/internet-golf/pkg/db"
+	"github.com/internet-golf/internet-golf/pkg/public"
+	"github.com/internet-golf/internet-golf/pkg/resources"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // input types ======================
 
 type DeployFilesBody struct {
 	Url                    string        `form:"url" required:"true" doc:"The URL of the deployment that you're updating." example:"mysite.mydomain.com"`
-	Contents               huma.FormFile `form:"contents" contentType:"application/gzip,application/octet-stream" doc:"A .tar.gz that contains the files to be deployed."`
-	KeepLeadingDirectories bool          `form:"keepLeadingDirectories" doc:"By default, if you upload a .tar.gz whose contents are all in one folder, the contents of that folder will be used instead of the folder itself. For example, if you upload a folder called 'dist' for the deployment 'mysite.com', the URL of your site content will not be at 'mysite.com/dist'. Setting this to true turns off that auto-unpacking." default:"false"`
+	Contents               huma.FormFile `form:"contents" contentType:"application/gzip,application/zip,application/x-tar,application/zstd,application/x-xz,application/octet-stream" doc:"An archive that contains the files to be deployed. The format is detected automatically; .tar.gz, .zip, .tar, .tar.zst, and .tar.xz all work. This has to come after the other fields in the form, since it's extracted while it's being uploaded."`
+	KeepLeadingDirectories bool          `form:"keepLeadingDirectories" doc:"By default, if you upload an archive whose contents are all in one folder, the contents of that folder will be used instead of the folder itself. For example, if you upload a folder called 'dist' for the deployment 'mysite.com', the URL of your site content will not be at 'mysite.com/dist'. Setting this to true turns off that auto-unpacking." default:"false"`
 	PreserveExistingFiles  bool          `form:"preserveExistingFiles" doc:"Leave the existing files for the current deployment in place instead of completely replacing them."`
+	Delete                 []string      `form:"delete" doc:"Paths of existing files or directories to delete. Can only be used if preserveExistingFiles is true." example:"[\"old-page.html\"]"`
 }
 type DeployFilesInput struct {
-	RawBody huma.MultipartFormFiles[DeployFilesBody]
+	// the form isn't parsed ahead of time; see Resolve
+	contentType   string
+	contentLength int64
+	body          io.Reader
+	limitedBody   *limitedBody
+}
+
+type ManifestFileModel struct {
+	Path string `json:"path" doc:"Where the file goes, relative to the root of the deployment." example:"assets/index.js"`
+	Hash string `json:"hash" doc:"The SHA-256 hash of the file's contents, in hex." example:"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"`
+}
+type ManifestBody struct {
+	Url   string              `json:"url" required:"true" doc:"The URL of the deployment that you're updating." example:"mysite.mydomain.com"`
+	Files []ManifestFileModel `json:"files" required:"true" doc:"Every file that the deployment should contain."`
+}
+type ManifestInput struct {
+	Body ManifestBody
+}
+
+type UploadBlobsBody struct {
+	Url   string          `form:"url" required:"true" doc:"The URL of the deployment that the files are for." example:"mysite.mydomain.com"`
+	Blobs []huma.FormFile `form:"blobs" required:"true" contentType:"application/octet-stream" doc:"The contents of files from a manifest. They're identified by their hashes, so their names don't matter."`
+}
+type UploadBlobsInput struct {
+	RawBody huma.MultipartFormFiles[UploadBlobsBody]
+}
+
+type DeployContainerBody struct {
+	Url          string        `form:"url" required:"true" doc:"The URL of the deployment that you're updating." example:"mysite.mydomain.com"`
+	Image        string        `form:"image" required:"true" doc:"The Docker image to run, like \"nginx:latest\". If no image archive is uploaded, this will be pulled from its registry." example:"nginx:latest"`
+	Port         int           `form:"port" required:"true" doc:"The port that the app inside the container listens on." example:"8080"`
+	ImageArchive huma.FormFile `form:"imageArchive" contentType:"application/x-tar,application/octet-stream" doc:"Optional image archive (as created by \"docker save\") to load the image from instead of pulling it."`
+}
+type DeployContainerInput struct {
+	RawBody huma.MultipartFormFiles[DeployContainerBody]
+}
+
+type DeployProcessBody struct {
+	Url        string        `form:"url" required:"true" doc:"The URL of the deployment that you're updating." example:"mysite.mydomain.com"`
+	Executable huma.FormFile `form:"executable" required:"true" contentType:"application/octet-stream,application/gzip,application/zip,application/x-tar,application/zstd,application/x-xz" doc:"Either a single executable or, if entrypoint is set, an archive (like a .tar.gz or .zip) that contains the executable. It will be started with the port that it should listen on in the PORT environment variable."`
+	Entrypoint string        `form:"entrypoint" doc:"If the uploaded file is an archive, this is the path of the executable within it." example:"bin/server"`
+}
+type DeployProcessInput struct {
+	RawBody huma.MultipartFormFiles[DeployProcessBody]
 }
 
 type DeployAliasBody struct {
@@ -32,6 +81,29 @@ type DeployAliasInput struct {
 	Body DeployAliasBody
 }
 
+type DeployProxyBody struct {
+	Url string `json:"url" required:"true" doc:"The URL of the deployment that you're updating." example:"mysite.mydomain.com"`
+	ProxyBase
+}
+type DeployProxyInput struct {
+	Body DeployProxyBody
+}
+
+type RollbackBody struct {
+	Url      string `json:"url" required:"true" doc:"The URL of the deployment that you're rolling back." example:"mysite.mydomain.com"`
+	Revision string `json:"revision,omitempty" required:"false" doc:"The hash (or a unique prefix of the hash) of the revision to roll back to. Defaults to the revision before the current one." example:"3f2a9c"`
+}
+type RollbackInput struct {
+	Body RollbackBody
+}
+
+type CreatePreviewBody struct {
+	Url string `json:"url" required:"true" doc:"The URL of the preview deployment. It has to be a subdomain of the preview domain of an existing deployment." example:"pr-123.preview.mydomain.com"`
+}
+type CreatePreviewInput struct {
+	Body CreatePreviewBody
+}
+
 type DeployAdminDashBody struct {
 	Url string `json:"url" required:"true" doc:"The URL that you want to deploy the admin dashboard to." example:"dash.mydomain.com"`
 }
@@ -53,11 +125,36 @@ type DeploymentBase struct {
 
 	// assuming that there won't be multiple external sources...
 	ExternalSource     string `json:"externalSource,omitempty" required:"false" doc:"Original repository for this deployment's source. Can include a branch name." example:"user/repo or user/repo#branch-name"`
-	ExternalSourceType string `json:"externalSourceType,omitempty" required:"false" enum:"Github" doc:"Place where the original repository lives."`
+	ExternalSourceType string `json:"externalSourceType,omitempty" required:"false" doc:"Place where the original repository lives. This has to be the name of one of the server's OIDC issuers; \"Github\" always works." example:"Github"`
 
 	Tags []string `json:"tags" required:"false" doc:"Tags used for metadata."`
 
 	PreserveExternalPath bool `json:"preserveExternalPath" required:"false" doc:"If this is true and the deployment url has a path like \"/thing\", then the \"/thing\" in the path will be transparently passed through to the underlying resource instead of being removed (which is the default)"`
+
+	PreviewDomain string `json:"previewDomain,omitempty" required:"false" doc:"If this is set, anyone who can deploy this deployment's repository (including from other branches) can create preview deployments at subdomains of this domain. It has to be the deployment's own domain or a subdomain of it, and no other deployment can be using it." example:"preview.mydomain.com"`
+	PreviewTtl    string `json:"previewTtl,omitempty" required:"false" doc:"How long preview deployments last before they are deleted, like \"72h\". Defaults to one week." example:"72h"`
+
+	SecurityHeaders []string          `json:"securityHeaders,omitempty" required:"false" enum:"hsts,csp,frame-options,content-type-options,referrer-policy" doc:"Presets for common security headers to add to responses from this deployment. \"hsts\" sets Strict-Transport-Security, \"csp\" sets a strict Content-Security-Policy that only allows resources from the deployment's own origin, \"frame-options\" sets X-Frame-Options to SAMEORIGIN, \"content-type-options\" sets X-Content-Type-Options to nosniff, and \"referrer-policy\" sets Referrer-Policy to strict-origin-when-cross-origin."`
+	HeaderRules     []HeaderRuleModel `json:"headerRules,omitempty" required:"false" doc:"Changes to make to the headers of responses from this deployment, in order. These are applied after the security headers, so they can override them."`
+
+	ErrorPages map[string]string `json:"errorPages,omitempty" required:"false" doc:"Pages from a static deployment's files to show when it responds with an error. The keys are status codes like \"404\", or ranges of them like \"5xx\" or \"50x\", and the values are the paths to the pages. More specific status codes take precedence over ranges." example:"{\"404\": \"/404.html\", \"5xx\": \"/50x.html\"}"`
+
+	BasicAuthUsers []BasicAuthUserModel `json:"basicAuthUsers,omitempty" required:"false" doc:"Users who can get into this deployment with HTTP basic auth. If there are any, the deployment isn't public anymore; visitors need one of these usernames and passwords (or the share token)."`
+	AllowedIps     []string             `json:"allowedIps,omitempty" required:"false" doc:"If there are any of these, only requests from these IP addresses or ranges (in CIDR notation) can get into this deployment." example:"[\"192.168.0.0/16\"]"`
+	DeniedIps      []string             `json:"deniedIps,omitempty" required:"false" doc:"Requests from these IP addresses or ranges (in CIDR notation) can't get into this deployment." example:"[\"203.0.113.7\"]"`
+	ShareToken     string               `json:"shareToken,omitempty" required:"false" doc:"If this is set, the deployment isn't public anymore, but anyone with a link that has this token as its golf_share query parameter can get in. After they visit the link, the token is kept in a cookie. It has to be at least 16 characters long, and can only have letters, numbers, _, and -. It's only included in responses for callers who can modify the deployment." example:"Yw3kq9Zp1xR7vT2m"`
+}
+
+type BasicAuthUserModel struct {
+	Username string `json:"username" doc:"Username for HTTP basic auth."`
+	Password string `json:"password,omitempty" required:"false" doc:"Password for HTTP basic auth. This is required when creating a deployment, and never included in responses, since only a hash of it is stored."`
+}
+
+type HeaderRuleModel struct {
+	Operation string `json:"operation" enum:"set,add,delete" doc:"Whether to set the header (replacing any existing values), add another value for it, or delete it."`
+	Name      string `json:"name" doc:"Name of the header." example:"Cache-Control"`
+	Value     string `json:"value,omitempty" required:"false" doc:"Value of the header. Not used for deletions." example:"max-age=3600"`
+	Path      string `json:"path,omitempty" required:"false" doc:"If this is set, the rule only applies to requests whose path (relative to the deployment's URL) matches this glob." example:"/assets/*"`
 }
 
 type SiteMeta struct {
@@ -69,10 +166,14 @@ type SiteMeta struct {
 // this could go in DeploymentBase if DeploymentCreateInput didn't cheat and use
 // it for input
 type DeploymentOutputBase struct {
-	Type      string   `json:"type" enum:"StaticSite,Alias,Empty" doc:"Type of deployment contents."`
+	Type      string   `json:"type" enum:"StaticSite,Alias,Container,Process,ReverseProxy,Empty" doc:"Type of deployment contents."`
 	CreatedAt string   `json:"createdAt" doc:"When the deployment was created (string in ISO-8601 format.)"`
 	UpdatedAt string   `json:"updatedAt" doc:"When the deployment was last updated (string in ISO-8601 format.)"`
 	Meta      SiteMeta `json:"meta" doc:"Metadata scraped from the deployment contents."`
+	PreviewOf string   `json:"previewOf,omitempty" doc:"If this is a preview deployment, the URL of the deployment that it's a preview of."`
+	ExpiresAt string   `json:"expiresAt,omitempty" doc:"If this is a preview deployment, when it will be deleted (string in ISO-8601 format.)"`
+
+	ShareTokenSet bool `json:"shareTokenSet" doc:"Whether the deployment has a share token. The token itself is only included for callers who can modify the deployment."`
 }
 
 type StaticSiteBase struct {
@@ -91,6 +192,32 @@ type AliasBase struct {
 	Redirect  *bool   `json:"redirect,omitempty" doc:"If this is true, visitors to this deployment's URL will be completely redirected to the URL that this alias is for."`
 }
 
+type ContainerBase struct {
+	// these values are pointers so that they will be properly omitted from the
+	// JSON response if not set by the API handler (which will happen when
+	// creating a DeploymentBody for a non-container deployment)
+	Image         *string `json:"image,omitempty" doc:"The Docker image that the deployment's container is running."`
+	ContainerPort *int    `json:"containerPort,omitempty" doc:"The port that the app inside the container listens on."`
+}
+
+type ProcessBase struct {
+	// this value is a pointer so that it will be properly omitted from the
+	// JSON response if not set by the API handler (which will happen when
+	// creating a DeploymentBody for a non-process deployment)
+	Executable *string `json:"executable,omitempty" doc:"The path to the executable that this deployment runs on the server."`
+}
+
+type ProxyBase struct {
+	// these values are pointers (or nil-able) so that they will be properly
+	// omitted from the JSON response if not set by the API handler (which will
+	// happen when creating a DeploymentBody for a non-proxy deployment)
+	Upstreams           []string          `json:"upstreams,omitempty" doc:"The servers that requests are proxied to, in host:port format." example:"[\"localhost:3000\"]"`
+	LoadBalancing       *string           `json:"loadBalancing,omitempty" enum:"random,round_robin,least_conn,first,ip_hash" doc:"How requests are distributed between the upstreams. Defaults to random."`
+	HealthCheckPath     *string           `json:"healthCheckPath,omitempty" doc:"If set, this path is periodically requested from each upstream, and upstreams that don't respond successfully stop receiving traffic." example:"/health"`
+	HealthCheckInterval *string           `json:"healthCheckInterval,omitempty" doc:"How often to perform health checks, like \"10s\". Defaults to 30 seconds." example:"10s"`
+	Headers             map[string]string `json:"headers,omitempty" doc:"Request headers to set on requests to the upstreams. Host and X-Forwarded-* can't be set."`
+}
+
 // this mostly exists to make absolutely sure that the different deployment base
 // types can be distinguished between by e.g. OpenAPI validation
 type EmptyBase struct {
@@ -106,12 +233,47 @@ type DeploymentModel struct {
 	DeploymentOutputBase
 	AliasBase
 	StaticSiteBase
+	ContainerBase
+	ProcessBase
+	ProxyBase
 	EmptyBase
 }
 type GetDeploymentOutput struct {
 	Body DeploymentModel
 }
 
+type RevisionModel struct {
+	Hash       string `json:"hash" doc:"Hash of the uploaded content. Used to identify the revision."`
+	CreatedAt  string `json:"createdAt" doc:"When the revision was uploaded (string in ISO-8601 format.)"`
+	UploadedBy string `json:"uploadedBy" doc:"Who uploaded the revision."`
+	Size       int64  `json:"size" doc:"Size of the uploaded archive in bytes."`
+	Active     bool   `json:"active" doc:"Whether this revision is the one currently being served."`
+}
+
+type GetRevisionsOutput struct {
+	Body struct {
+		Revisions []RevisionModel `json:"revisions" required:"true" doc:"The deployment's content revisions, newest first."`
+	}
+}
+
+type CheckManifestOutput struct {
+	Body struct {
+		Missing []string `json:"missing" required:"true" doc:"Hashes of the files that the server doesn't have yet. These have to be uploaded before the manifest is deployed."`
+	}
+}
+
+type UploadBlobsOutput struct {
+	Body struct {
+		Stored []string `json:"stored" required:"true" doc:"Hashes of the uploaded files, in the order they were uploaded."`
+	}
+}
+
+type GetProcessLogsOutput struct {
+	Body struct {
+		Logs string `json:"logs" doc:"The most recent output (stdout and stderr) of the deployment's process."`
+	}
+}
+
 type GetDeploymentsOutput struct {
 	Body struct {
 		Deployments []DeploymentModel `json:"deployments" required:"true"`
@@ -120,7 +282,9 @@ type GetDeploymentsOutput struct {
 
 // api code =================================
 
-func deploymentToApiModel(deployment db.Deployment) (DeploymentModel, error) {
+// the share token is only included if the caller could change it anyway, since
+// anyone with it can get into the deployment
+func deploymentToApiModel(deployment db.Deployment, permissions Permissions) (DeploymentModel, error) {
 	var output DeploymentModel
 
 	output.CreatedAt = deployment.CreatedAt.UTC().Format(time.RFC3339)
@@ -133,6 +297,33 @@ func deploymentToApiModel(deployment db.Deployment) (DeploymentModel, error) {
 		Tags:                 deployment.Tags,
 		PreserveExternalPath: deployment.PreserveExternalPath,
 		Name:                 deployment.Name,
+		PreviewDomain:        deployment.PreviewDomain,
+	}
+	if deployment.PreviewTTL > 0 {
+		output.PreviewTtl = deployment.PreviewTTL.String()
+	}
+	output.SecurityHeaders = deployment.SecurityHeaders
+	for _, rule := range deployment.HeaderRules {
+		output.HeaderRules = append(output.HeaderRules, HeaderRuleModel{
+			Operation: string(rule.Operation),
+			Name:      rule.Name,
+			Value:     rule.Value,
+			Path:      rule.Path,
+		})
+	}
+	output.ErrorPages = deployment.ErrorPages
+	for _, user := range deployment.AccessControl.BasicAuthUsers {
+		output.BasicAuthUsers = append(output.BasicAuthUsers, BasicAuthUserModel{Username: user.Username})
+	}
+	output.AllowedIps = deployment.AccessControl.AllowedIps
+	output.DeniedIps = deployment.AccessControl.DeniedIps
+	output.ShareTokenSet = len(deployment.AccessControl.ShareToken) > 0
+	if permissions.CanModifyDeployment(&deployment) {
+		output.ShareToken = deployment.AccessControl.ShareToken
+	}
+	if !deployment.ExpiresAt.IsZero() {
+		output.PreviewOf = deployment.PreviewOf.String()
+		output.ExpiresAt = deployment.ExpiresAt.UTC().Format(time.RFC3339)
 	}
 
 	output.DeploymentOutputBase.Meta = SiteMeta{
@@ -150,6 +341,29 @@ func deploymentToApiModel(deployment db.Deployment) (DeploymentModel, error) {
 		aliasedTo := deployment.AliasedTo.String()
 		output.AliasBase.AliasedTo = &aliasedTo
 		output.AliasBase.Redirect = &deployment.Redirect
+	} else if deployment.ServedThingType == db.DockerContainer {
+		output.Type = "Container"
+		output.ContainerBase.Image = &deployment.ContainerImage
+		output.ContainerBase.ContainerPort = &deployment.ContainerPort
+	} else if deployment.ServedThingType == db.NativeProcess {
+		output.Type = "Process"
+		output.ProcessBase.Executable = &deployment.ProcessExecutable
+	} else if deployment.ServedThingType == db.ReverseProxy {
+		output.Type = "ReverseProxy"
+		output.ProxyBase.Upstreams = deployment.ProxyUpstreams
+		if len(output.ProxyBase.Upstreams) == 0 {
+			output.ProxyBase.Upstreams = []string{deployment.ServedThing}
+		}
+		loadBalancing := deployment.ProxyLoadBalancing
+		if len(loadBalancing) == 0 {
+			loadBalancing = "random"
+		}
+		output.ProxyBase.LoadBalancing = &loadBalancing
+		if len(deployment.ProxyHealthCheckPath) > 0 {
+			output.ProxyBase.HealthCheckPath = &deployment.ProxyHealthCheckPath
+			output.ProxyBase.HealthCheckInterval = &deployment.ProxyHealthCheckInterval
+		}
+		output.ProxyBase.Headers = deployment.ProxyHeaders
 	} else if len(deployment.ServedThingType) == 0 {
 		output.Type = "Empty"
 		noContentYet := true
@@ -160,6 +374,54 @@ func deploymentToApiModel(deployment db.Deployment) (DeploymentModel, error) {
 	return output, nil
 }
 
+const maxManifestBytes = 16 * 1024 * 1024
+
+func manifestFromModels(files []ManifestFileModel) []resources.ManifestEntry {
+	manifest := []resources.ManifestEntry{}
+	for _, f := range files {
+		manifest = append(manifest, resources.ManifestEntry{Path: f.Path, Hash: f.Hash})
+	}
+	return manifest
+}
+
+// the problems with the _redirects and _headers files of the deployment's
+// current content
+func (a *AdminApi) siteConfigErrors(url db.Url) []string {
+	deployment, err := a.web.GetDeploymentByUrl(&url)
+	if err != nil {
+		return nil
+	}
+	return deployment.SiteConfigErrors
+}
+
+// the hash of the deployment's newest content revision, for the audit log
+func (a *AdminApi) latestRevisionHash(url db.Url) string {
+	deployment, err := a.web.GetDeploymentByUrl(&url)
+	if err != nil || len(deployment.Revisions) == 0 {
+		return ""
+	}
+	return deployment.Revisions[len(deployment.Revisions)-1].Hash
+}
+
+// errors from changing a deployment are usually the request's fault (like
+// settings that the public web server won't accept), unless the change
+// couldn't be saved
+func busErrorToHuma(err error) error {
+	if errors.Is(err, ErrDeploymentsNotSaved) {
+		return huma.Error500InternalServerError(err.Error())
+	}
+	return huma.Error400BadRequest(err.Error())
+}
+
+// creating a deployment at a url where there already is one changes the
+// existing deployment's settings, so that needs permission to modify it
+func (a *AdminApi) canPutDeployment(permissions Permissions, url db.Url) bool {
+	if existing, err := a.web.GetDeploymentByUrl(&url); err == nil {
+		return permissions.CanModifyDeployment(&existing)
+	}
+	return permissions.CanCreateDeployment(url)
+}
+
 func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 
 	// TODO: abstract out permissions checks, which are currently very repetitive
@@ -176,9 +438,25 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		if !permissionsOk {
 			return nil, huma.Error500InternalServerError("Auth check failed somehow")
 		}
+		auditDetailsFrom(ctx).Target = urlFromString(input.Body.Url).String()
+
+		if !a.canPutDeployment(permissions, urlFromString(input.Body.Url)) {
+			return nil, huma.Error403Forbidden("Not authorized to create deployments")
+		}
 
-		if !permissions.CanCreateDeployment() {
-			return nil, huma.Error401Unauthorized("Not authorized to create deployments")
+		// previews are deployments at the preview domain, so allowing them
+		// takes permission to create deployments there
+		if len(input.Body.PreviewDomain) > 0 &&
+			!permissions.CanCreateDeployment(db.Url{Domain: input.Body.PreviewDomain}) {
+			return nil, huma.Error403Forbidden(
+				fmt.Sprintf("Not authorized to create deployments at %s", input.Body.PreviewDomain),
+			)
+		}
+
+		if len(input.Body.ExternalSourceType) > 0 && !a.hasOidcIssuer(input.Body.ExternalSourceType) {
+			return nil, huma.Error400BadRequest(
+				fmt.Sprintf("there's no OIDC issuer named \"%s\"", input.Body.ExternalSourceType),
+			)
 		}
 
 		tags := input.Body.Tags
@@ -186,6 +464,63 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 			tags = []string{}
 		}
 
+		var previewTtl time.Duration
+		if len(input.Body.PreviewTtl) > 0 {
+			var err error
+			previewTtl, err = time.ParseDuration(input.Body.PreviewTtl)
+			if err != nil || previewTtl <= 0 {
+				return nil, huma.Error400BadRequest(
+					fmt.Sprintf("invalid preview TTL \"%s\"", input.Body.PreviewTtl),
+				)
+			}
+		}
+
+		headerRules := []db.HeaderRule{}
+		for _, model := range input.Body.HeaderRules {
+			rule := db.HeaderRule{
+				Operation: db.HeaderOperation(model.Operation),
+				Name:      model.Name,
+				Value:     model.Value,
+				Path:      model.Path,
+			}
+			if err := public.ValidateHeaderRule(rule); err != nil {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			headerRules = append(headerRules, rule)
+		}
+
+		for status, page := range input.Body.ErrorPages {
+			if err := public.ValidateErrorPage(status, page); err != nil {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+		}
+
+		accessControl := db.AccessControl{
+			AllowedIps: input.Body.AllowedIps,
+			DeniedIps:  input.Body.DeniedIps,
+			ShareToken: input.Body.ShareToken,
+		}
+		for _, user := range input.Body.BasicAuthUsers {
+			if len(user.Password) == 0 {
+				return nil, huma.Error400BadRequest("user " + user.Username + " needs a password")
+			}
+			// this is checked on every request that doesn't have a cached
+			// result, so it uses the default cost instead of the higher
+			// one that's used for bearer tokens
+			passwordHash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+			if err != nil {
+				return nil, huma.Error400BadRequest(
+					fmt.Sprintf("could not hash the password for %s: %v", user.Username, err),
+				)
+			}
+			accessControl.BasicAuthUsers = append(accessControl.BasicAuthUsers, db.BasicAuthUser{
+				Username: user.Username, PasswordHash: string(passwordHash),
+			})
+		}
+		if err := public.ValidateAccessControl(accessControl); err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
 		putDeploymentErr := a.web.SetupDeployment(db.DeploymentMetadata{
 			Url:                  urlFromString(input.Body.Url),
 			ExternalSource:       input.Body.ExternalSource,
@@ -193,9 +528,15 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 			Tags:                 tags,
 			PreserveExternalPath: input.Body.PreserveExternalPath,
 			Name:                 input.Body.Name,
+			PreviewDomain:        input.Body.PreviewDomain,
+			PreviewTTL:           previewTtl,
+			SecurityHeaders:      input.Body.SecurityHeaders,
+			HeaderRules:          headerRules,
+			ErrorPages:           input.Body.ErrorPages,
+			AccessControl:        accessControl,
 		})
 		if putDeploymentErr != nil {
-			return nil, putDeploymentErr
+			return nil, busErrorToHuma(putDeploymentErr)
 		}
 		var output SuccessOutput
 		output.Body.Success = true
@@ -222,6 +563,21 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		DeploymentOutputBase
 		AliasBase
 	}
+	type ContainerDeployment struct {
+		DeploymentBase
+		DeploymentOutputBase
+		ContainerBase
+	}
+	type ProcessDeployment struct {
+		DeploymentBase
+		DeploymentOutputBase
+		ProcessBase
+	}
+	type ReverseProxyDeployment struct {
+		DeploymentBase
+		DeploymentOutputBase
+		ProxyBase
+	}
 	type EmptyDeployment struct {
 		DeploymentBase
 		DeploymentOutputBase
@@ -231,13 +587,19 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		OneOf: []*huma.Schema{
 			registry.Schema(reflect.TypeFor[StaticSiteDeployment](), true, ""),
 			registry.Schema(reflect.TypeFor[AliasDeployment](), true, ""),
+			registry.Schema(reflect.TypeFor[ContainerDeployment](), true, ""),
+			registry.Schema(reflect.TypeFor[ProcessDeployment](), true, ""),
+			registry.Schema(reflect.TypeFor[ReverseProxyDeployment](), true, ""),
 			registry.Schema(reflect.TypeFor[EmptyDeployment](), true, ""),
 		},
 		Discriminator: &huma.Discriminator{
 			PropertyName: "type", Mapping: map[string]string{
-				"StaticSite": "#/components/schemas/StaticSiteDeployment",
-				"Alias":      "#/components/schemas/AliasDeployment",
-				"Empty":      "#/components/schemas/EmptyDeployment",
+				"StaticSite":   "#/components/schemas/StaticSiteDeployment",
+				"Alias":        "#/components/schemas/AliasDeployment",
+				"Container":    "#/components/schemas/ContainerDeployment",
+				"Process":      "#/components/schemas/ProcessDeployment",
+				"ReverseProxy": "#/components/schemas/ReverseProxyDeployment",
+				"Empty":        "#/components/schemas/EmptyDeployment",
 			},
 		},
 	}
@@ -271,7 +633,7 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		}
 
 		// make a copy of the deployment bus' deployments
-		deployments := append([]db.Deployment{}, a.web.deployments...)
+		deployments := slices.Clone(a.web.Deployments())
 		// delete the deployments that the current user shouldn't be able to see
 		deployments = slices.DeleteFunc(deployments, func(d db.Deployment) bool {
 			return !permissions.CanViewDeployment(&d) || d.Internal
@@ -280,7 +642,7 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		var output GetDeploymentsOutput
 		output.Body.Deployments = []DeploymentModel{}
 		for _, d := range deployments {
-			model, error := deploymentToApiModel(d)
+			model, error := deploymentToApiModel(d, permissions)
 			if error != nil {
 				fmt.Println(error.Error())
 			} else {
@@ -322,7 +684,7 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 			)
 		}
 
-		model, err := deploymentToApiModel(deployment)
+		model, err := deploymentToApiModel(deployment, permissions)
 		if err != nil {
 			return nil, huma.Error500InternalServerError(err.Error())
 		}
@@ -341,15 +703,23 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		if !permissionsOk {
 			return nil, huma.Error500InternalServerError("Auth check failed somehow")
 		}
+		auditDetailsFrom(ctx).Target = urlFromString(input.Body.Url).String()
 
-		if !permissions.CanCreateDeployment() {
-			return nil, huma.Error401Unauthorized("Not authorized to create deployments")
+		if !a.canPutDeployment(permissions, urlFromString(input.Body.Url)) {
+			return nil, huma.Error403Forbidden("Not authorized to create deployments")
+		}
+		// an alias shows the other deployment's content, so it shouldn't be
+		// possible to make one for a deployment that can't be seen
+		aliasedTo := urlFromString(*input.Body.AliasBase.AliasedTo)
+		if target, err := a.web.GetDeploymentByUrl(&aliasedTo); err == nil &&
+			!permissions.CanViewDeployment(&target) {
+			return nil, huma.Error403Forbidden(
+				fmt.Sprintf("insufficient permissions to view deployment \"%s\"", aliasedTo),
+			)
 		}
 
 		err := a.web.PutAliasDeployment(
-			urlFromString(input.Body.Url),
-			urlFromString(*input.Body.AliasBase.AliasedTo),
-			*input.Body.AliasBase.Redirect,
+			urlFromString(input.Body.Url), aliasedTo, *input.Body.AliasBase.Redirect,
 		)
 
 		if err != nil {
@@ -362,15 +732,107 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		return &output, nil
 	})
 
+	huma.Register(api, huma.Operation{
+		OperationID: "CreatePreview",
+		Method:      http.MethodPut,
+		Description: "Create a preview deployment under another deployment's preview domain, or extend the lifetime of an existing one.",
+		Path:        "/deploy/preview",
+	}, func(ctx context.Context, input *CreatePreviewInput) (*SuccessOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		url := urlFromString(input.Body.Url)
+		auditDetailsFrom(ctx).Target = url.String()
+		parent, err := a.web.FindPreviewParent(url)
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
+		if !permissions.CanCreatePreview(&parent) {
+			return nil, huma.Error403Forbidden(
+				fmt.Sprintf("insufficient permissions to create previews of \"%s\"", parent.Url),
+			)
+		}
+
+		preview, err := a.web.SetupPreviewDeployment(parent, url)
+		if err != nil {
+			return nil, busErrorToHuma(err)
+		}
+
+		var output SuccessOutput
+		output.Body.Success = true
+		output.Body.Message = fmt.Sprintf(
+			"Created preview deployment with url %s (expires %s)",
+			preview.Url, preview.ExpiresAt.UTC().Format(time.RFC3339),
+		)
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "CreateProxy",
+		Method:      http.MethodPut,
+		Description: "Create a deployment that reverse-proxies to one or more existing servers. This needs permission to manage the server, since the servers can be anywhere that the server can reach, including its own internal services.",
+		Path:        "/deploy/proxy",
+	}, func(ctx context.Context, input *DeployProxyInput) (*SuccessOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+		auditDetailsFrom(ctx).Target = urlFromString(input.Body.Url).String()
+
+		if !a.canPutDeployment(permissions, urlFromString(input.Body.Url)) {
+			return nil, huma.Error403Forbidden("Not authorized to create deployments")
+		}
+		// otherwise, anyone who can deploy to one site could use it to get at
+		// things on the server's network that aren't supposed to be public,
+		// like the admin api
+		if !permissions.CanManageServer() {
+			return nil, huma.Error403Forbidden("Not authorized to proxy to other servers")
+		}
+
+		proxy := db.DeploymentContent{
+			ProxyUpstreams: input.Body.Upstreams,
+			ProxyHeaders:   input.Body.Headers,
+		}
+		if input.Body.LoadBalancing != nil {
+			proxy.ProxyLoadBalancing = *input.Body.LoadBalancing
+		}
+		if input.Body.HealthCheckPath != nil {
+			proxy.ProxyHealthCheckPath = *input.Body.HealthCheckPath
+		}
+		if input.Body.HealthCheckInterval != nil {
+			proxy.ProxyHealthCheckInterval = *input.Body.HealthCheckInterval
+		}
+
+		err := a.web.PutReverseProxyDeployment(urlFromString(input.Body.Url), proxy)
+		if err != nil {
+			return nil, busErrorToHuma(err)
+		}
+
+		var output SuccessOutput
+		output.Body.Success = true
+		output.Body.Message = "Created reverse proxy deployment"
+		return &output, nil
+	})
+
 	huma.Register(api, huma.Operation{
 		OperationID: "DeployFiles",
 		Description: "Put files in an existing deployment.",
 		Method:      http.MethodPut,
 		Path:        "/deploy/files",
+		RequestBody: multipartRequestBody[DeployFilesBody](),
+		// huma doesn't read the body for this one (see Resolve), so this is
+		// applied in Resolve
+		BodyReadTimeout: uploadReadTimeout,
 	}, func(
 		ctx context.Context, input *DeployFilesInput,
 	) (*SuccessOutput, error) {
-		formData := input.RawBody.Data()
+		formData, contents, formErr := input.readFields(a.config.MaxUploadSize)
+		if formErr != nil {
+			return nil, formErr
+		}
 
 		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
 		if !permissionsOk {
@@ -378,6 +840,7 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		}
 
 		url := urlFromString(formData.Url)
+		auditDetailsFrom(ctx).Target = url.String()
 		deployment, findDeploymentError := a.web.GetDeploymentByUrl(&url)
 		if findDeploymentError != nil {
 			return nil, huma.Error404NotFound(
@@ -385,23 +848,395 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 			)
 		}
 
-		if !permissions.CanModifyDeployment(&deployment) {
+		if !permissions.CanDeployToDeployment(&deployment) {
 			return nil, huma.Error403Forbidden(
 				fmt.Sprintf("insufficient permissions to modify deployment \"%s\"", url),
 			)
 		}
 
-		filesErr := a.web.PutStaticFilesForDeployment(deployment, formData.Contents, formData.KeepLeadingDirectories)
+		if len(formData.Delete) > 0 && !formData.PreserveExistingFiles {
+			return nil, huma.Error400BadRequest(
+				"Files can only be deleted if preserveExistingFiles is true",
+			)
+		}
+
+		var filesErr error
+		if formData.PreserveExistingFiles {
+			filesErr = a.web.PatchStaticFilesForDeployment(
+				deployment, contents, formData.KeepLeadingDirectories,
+				formData.Delete, permissions.Identity(),
+			)
+		} else {
+			filesErr = a.web.PutStaticFilesForDeployment(
+				deployment, contents, formData.KeepLeadingDirectories,
+				permissions.Identity(),
+			)
+		}
 
+		if input.uploadTooLarge() {
+			return nil, uploadTooLargeError(a.config.MaxUploadSize)
+		}
+		var limitErr resources.ArchiveLimitError
+		if errors.As(filesErr, &limitErr) {
+			return nil, huma.NewError(http.StatusRequestEntityTooLarge, limitErr.Error())
+		}
 		if filesErr != nil {
 			return nil, huma.Error500InternalServerError(
 				"Error occurred while unpacking uploaded files: " + filesErr.Error(),
 			)
 		}
 
+		auditDetailsFrom(ctx).ContentHash = a.latestRevisionHash(url)
+
+		output := SuccessOutput{}
+		output.Body.Success = true
+		output.Body.Message = "Updated content for " + url.String()
+		output.Body.Warnings = a.siteConfigErrors(url)
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "CheckManifest",
+		Description: "Find out which of the files in a manifest need to be uploaded before it can be deployed. Files only count as uploaded for the deployment they were uploaded to.",
+		Method:      http.MethodPost,
+		Path:        "/deploy/manifest/check",
+		// manifests for big sites can be bigger than the default limit of 1MB
+		MaxBodyBytes: maxManifestBytes,
+		Metadata:     map[string]any{skipAuditMetadata: true},
+	}, func(ctx context.Context, input *ManifestInput) (*CheckManifestOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		url := urlFromString(input.Body.Url)
+		deployment, findDeploymentError := a.web.GetDeploymentByUrl(&url)
+		if findDeploymentError != nil {
+			return nil, huma.Error404NotFound(
+				fmt.Sprintf("could not find deployment with URL \"%s\"", url),
+			)
+		}
+
+		if !permissions.CanDeployToDeployment(&deployment) {
+			return nil, huma.Error403Forbidden(
+				fmt.Sprintf("insufficient permissions to modify deployment \"%s\"", url),
+			)
+		}
+
+		missing, err := a.web.MissingBlobs(deployment, manifestFromModels(input.Body.Files))
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
+		var output CheckManifestOutput
+		output.Body.Missing = missing
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID:     "UploadBlobs",
+		Description:     "Upload the files from a manifest that the server doesn't have yet.",
+		Method:          http.MethodPut,
+		Path:            "/deploy/blobs",
+		BodyReadTimeout: uploadReadTimeout,
+		Metadata:        map[string]any{limitedUploadMetadata: true},
+		Middlewares:     huma.Middlewares{readLimitedForm(api, a.config)},
+	}, func(ctx context.Context, input *UploadBlobsInput) (*UploadBlobsOutput, error) {
+		formData := input.RawBody.Data()
+
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		url := urlFromString(formData.Url)
+		auditDetailsFrom(ctx).Target = url.String()
+		deployment, findDeploymentError := a.web.GetDeploymentByUrl(&url)
+		if findDeploymentError != nil {
+			return nil, huma.Error404NotFound(
+				fmt.Sprintf("could not find deployment with URL \"%s\"", url),
+			)
+		}
+
+		if !permissions.CanDeployToDeployment(&deployment) {
+			return nil, huma.Error403Forbidden(
+				fmt.Sprintf("insufficient permissions to modify deployment \"%s\"", url),
+			)
+		}
+
+		var output UploadBlobsOutput
+		output.Body.Stored = []string{}
+		for _, blob := range formData.Blobs {
+			hash, err := a.web.PutBlob(deployment, blob)
+			if err != nil {
+				return nil, huma.Error500InternalServerError(err.Error())
+			}
+			output.Body.Stored = append(output.Body.Stored, hash)
+		}
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "DeployManifest",
+		Description: "Put files in an existing deployment, using files that were already uploaded.",
+		Method:      http.MethodPut,
+		Path:        "/deploy/manifest",
+		// manifests for big sites can be bigger than the default limit of 1MB
+		MaxBodyBytes: maxManifestBytes,
+	}, func(ctx context.Context, input *ManifestInput) (*SuccessOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		url := urlFromString(input.Body.Url)
+		auditDetailsFrom(ctx).Target = url.String()
+		deployment, findDeploymentError := a.web.GetDeploymentByUrl(&url)
+		if findDeploymentError != nil {
+			return nil, huma.Error404NotFound(
+				fmt.Sprintf("could not find deployment with URL \"%s\"", url),
+			)
+		}
+
+		if !permissions.CanDeployToDeployment(&deployment) {
+			return nil, huma.Error403Forbidden(
+				fmt.Sprintf("insufficient permissions to modify deployment \"%s\"", url),
+			)
+		}
+
+		err := a.web.PutManifestForDeployment(
+			deployment, manifestFromModels(input.Body.Files), permissions.Identity(),
+		)
+		if err != nil {
+			return nil, busErrorToHuma(err)
+		}
+
+		auditDetailsFrom(ctx).ContentHash = a.latestRevisionHash(url)
+
 		output := SuccessOutput{}
 		output.Body.Success = true
 		output.Body.Message = "Updated content for " + url.String()
+		output.Body.Warnings = a.siteConfigErrors(url)
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "GetRevisions",
+		Description: "Retrieve the content revisions of a deployment.",
+		Method:      http.MethodGet,
+		Path:        "/deployment/{url}/revisions",
+	}, func(ctx context.Context, input *struct {
+		Url string `path:"url"`
+	}) (*GetRevisionsOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		url := urlFromString(input.Url)
+		deployment, err := a.web.GetDeploymentByUrl(&url)
+		if err != nil || !permissions.CanViewDeployment(&deployment) || deployment.Internal {
+			return nil, huma.Error404NotFound(
+				fmt.Sprintf("Could not find deployment with URL \"%s\"", url),
+			)
+		}
+
+		var output GetRevisionsOutput
+		output.Body.Revisions = []RevisionModel{}
+		for _, r := range slices.Backward(deployment.Revisions) {
+			output.Body.Revisions = append(output.Body.Revisions, RevisionModel{
+				Hash:       r.Hash,
+				CreatedAt:  r.CreatedAt.UTC().Format(time.RFC3339),
+				UploadedBy: r.UploadedBy,
+				Size:       r.Size,
+				Active:     deployment.ServedThingType == db.StaticFiles && r.Path == deployment.ServedThing,
+			})
+		}
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "Rollback",
+		Description: "Point a deployment back at one of its earlier content revisions.",
+		Method:      http.MethodPut,
+		Path:        "/deploy/rollback",
+	}, func(ctx context.Context, input *RollbackInput) (*SuccessOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		url := urlFromString(input.Body.Url)
+		auditDetailsFrom(ctx).Target = url.String()
+		deployment, findDeploymentError := a.web.GetDeploymentByUrl(&url)
+		if findDeploymentError != nil {
+			return nil, huma.Error404NotFound(
+				fmt.Sprintf("could not find deployment with URL \"%s\"", url),
+			)
+		}
+
+		if !permissions.CanDeployToDeployment(&deployment) {
+			return nil, huma.Error403Forbidden(
+				fmt.Sprintf("insufficient permissions to modify deployment \"%s\"", url),
+			)
+		}
+
+		revision, err := a.web.RollbackDeployment(url, input.Body.Revision)
+		if err != nil {
+			return nil, busErrorToHuma(err)
+		}
+
+		output := SuccessOutput{}
+		output.Body.Success = true
+		auditDetailsFrom(ctx).ContentHash = revision.Hash
+		output.Body.Message = fmt.Sprintf("Rolled %s back to revision %s", url, revision.Hash)
+		output.Body.Warnings = a.siteConfigErrors(url)
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID:     "DeployContainer",
+		Description:     "Run a Docker container for an existing deployment.",
+		Method:          http.MethodPut,
+		Path:            "/deploy/container",
+		BodyReadTimeout: uploadReadTimeout,
+		Metadata:        map[string]any{limitedUploadMetadata: true},
+		Middlewares:     huma.Middlewares{readLimitedForm(api, a.config)},
+	}, func(
+		ctx context.Context, input *DeployContainerInput,
+	) (*SuccessOutput, error) {
+		formData := input.RawBody.Data()
+
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, fmt.Errorf("Auth check failed somehow")
+		}
+
+		url := urlFromString(formData.Url)
+		auditDetailsFrom(ctx).Target = url.String()
+		deployment, findDeploymentError := a.web.GetDeploymentByUrl(&url)
+		if findDeploymentError != nil {
+			return nil, huma.Error404NotFound(
+				fmt.Sprintf("could not find deployment with URL \"%s\"", url),
+			)
+		}
+
+		if !permissions.CanDeployToDeployment(&deployment) {
+			return nil, huma.Error403Forbidden(
+				fmt.Sprintf("insufficient permissions to modify deployment \"%s\"", url),
+			)
+		}
+
+		var imageArchive io.Reader
+		if formData.ImageArchive.IsSet {
+			imageArchive = formData.ImageArchive
+		}
+
+		containerErr := a.web.PutContainerForDeployment(
+			deployment, formData.Image, imageArchive, formData.Port,
+		)
+		if containerErr != nil {
+			return nil, huma.Error500InternalServerError(
+				"Error occurred while starting container: " + containerErr.Error(),
+			)
+		}
+
+		// the image isn't a hash, but it's what identifies the content
+		auditDetailsFrom(ctx).ContentHash = formData.Image
+
+		output := SuccessOutput{}
+		output.Body.Success = true
+		output.Body.Message = "Started container for " + url.String()
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID:     "DeployProcess",
+		Description:     "Run an executable as a supervised process for an existing deployment. This needs permission to manage the server, since the process runs as the server's user.",
+		Method:          http.MethodPut,
+		Path:            "/deploy/process",
+		BodyReadTimeout: uploadReadTimeout,
+		Metadata:        map[string]any{limitedUploadMetadata: true},
+		Middlewares:     huma.Middlewares{readLimitedForm(api, a.config)},
+	}, func(
+		ctx context.Context, input *DeployProcessInput,
+	) (*SuccessOutput, error) {
+		formData := input.RawBody.Data()
+
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, fmt.Errorf("Auth check failed somehow")
+		}
+
+		url := urlFromString(formData.Url)
+		auditDetailsFrom(ctx).Target = url.String()
+		deployment, findDeploymentError := a.web.GetDeploymentByUrl(&url)
+		if findDeploymentError != nil {
+			return nil, huma.Error404NotFound(
+				fmt.Sprintf("could not find deployment with URL \"%s\"", url),
+			)
+		}
+
+		if !permissions.CanDeployToDeployment(&deployment) {
+			return nil, huma.Error403Forbidden(
+				fmt.Sprintf("insufficient permissions to modify deployment \"%s\"", url),
+			)
+		}
+
+		// the process runs on the server as the same user as the server, so
+		// being able to deploy one is as good as being able to manage the
+		// server
+		if !permissions.CanManageServer() {
+			return nil, huma.Error403Forbidden("Not authorized to run processes on the server")
+		}
+
+		processErr := a.web.PutProcessForDeployment(
+			deployment, formData.Executable, formData.Entrypoint,
+		)
+		var limitErr resources.ArchiveLimitError
+		if errors.As(processErr, &limitErr) {
+			return nil, huma.NewError(http.StatusRequestEntityTooLarge, limitErr.Error())
+		}
+		if processErr != nil {
+			return nil, huma.Error500InternalServerError(
+				"Error occurred while starting process: " + processErr.Error(),
+			)
+		}
+
+		output := SuccessOutput{}
+		output.Body.Success = true
+		output.Body.Message = "Started process for " + url.String()
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "GetProcessLogs",
+		Description: "Retrieve the recent output of a process deployment.",
+		Method:      http.MethodGet,
+		Path:        "/deployment/{url}/logs",
+	}, func(ctx context.Context, input *struct {
+		Url string `path:"url"`
+	}) (*GetProcessLogsOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		url := urlFromString(input.Url)
+		deployment, err := a.web.GetDeploymentByUrl(&url)
+		if err != nil || !permissions.CanViewDeployment(&deployment) || deployment.Internal {
+			return nil, huma.Error404NotFound(
+				fmt.Sprintf("Could not find deployment with URL \"%s\"", url),
+			)
+		}
+
+		logs, err := a.web.GetProcessLogs(deployment)
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
+		var output GetProcessLogsOutput
+		output.Body.Logs = string(logs)
 		return &output, nil
 	})
 
@@ -415,12 +1250,15 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		if !permissionsOk {
 			return nil, huma.Error500InternalServerError("Auth check failed somehow")
 		}
+		auditDetailsFrom(ctx).Target = urlFromString(input.Body.Url).String()
 
-		if !permissions.CanCreateDeployment() {
-			return nil, huma.Error401Unauthorized("Not authorized to create deployments")
+		if !permissions.CanManageServer() {
+			return nil, huma.Error403Forbidden("Not authorized to deploy the admin dashboard")
 		}
 
-		a.web.PutAdminDash(urlFromString(input.Body.Url))
+		if err := a.web.PutAdminDash(urlFromString(input.Body.Url)); err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
 
 		var output SuccessOutput
 		output.Body.Success = true
@@ -442,6 +1280,7 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 		}
 
 		url := urlFromString(input.Url)
+		auditDetailsFrom(ctx).Target = url.String()
 		deployment, findDeploymentError := a.web.GetDeploymentByUrl(&url)
 		if findDeploymentError != nil {
 			return nil, huma.Error404NotFound(
@@ -449,7 +1288,7 @@ func (a *AdminApi) addDeploymentRoutes(api huma.API) {
 			)
 		}
 
-		if !permissions.CanModifyDeployment(&deployment) {
+		if !permissions.CanDeleteDeployment(&deployment) {
 			return nil, huma.Error403Forbidden(
 				fmt.Sprintf("Insufficient permissions to delete deployment \"%s\"", url),
 			)
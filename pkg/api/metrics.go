@@ -0,0 +1,85 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+)
+
+type authMetrics struct {
+	registry *prometheus.Registry
+	// labeled with the issuer's name and the result, which is "valid",
+	// "invalid" (for tokens that were rejected), or "error" (for tokens that
+	// couldn't be checked)
+	oidcVerificationSeconds *prometheus.HistogramVec
+	keyFetchFailures        *prometheus.CounterVec
+}
+
+func newAuthMetrics() *authMetrics {
+	metrics := &authMetrics{
+		registry: prometheus.NewRegistry(),
+		oidcVerificationSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "golf_oidc_verification_seconds",
+			Help: "How long it takes to verify OIDC tokens, including fetching signing keys.",
+			// fetching keys can take seconds, while verifying with cached keys
+			// should take well under a millisecond
+			Buckets: []float64{.0005, .001, .005, .01, .05, .1, .5, 1, 5, 10},
+		}, []string{"issuer", "result"}),
+		keyFetchFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "golf_oidc_key_fetch_failures_total",
+			Help: "How many times an OIDC issuer's signing keys couldn't be fetched.",
+		}, []string{"issuer"}),
+	}
+	metrics.registry.MustRegister(metrics.oidcVerificationSeconds, metrics.keyFetchFailures)
+	return metrics
+}
+
+type MetricsOutput struct {
+	ContentType string `header:"Content-Type"`
+	Body        []byte
+}
+
+func (a *AdminApi) addMetricsRoutes(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "GetMetrics",
+		Description: "Get the server's metrics in the Prometheus text format.",
+		Method:      http.MethodGet,
+		Path:        "/metrics",
+		Responses: map[string]*huma.Response{
+			"200": {
+				Description: "Metrics in the Prometheus text format",
+				Content: map[string]*huma.MediaType{
+					"text/plain": {Schema: &huma.Schema{Type: huma.TypeString}},
+				},
+			},
+		},
+	}, func(ctx context.Context, input *struct{}) (*MetricsOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		if !permissions.CanManageServer() {
+			return nil, huma.Error403Forbidden("Not authorized to view metrics")
+		}
+
+		families, err := a.auth.Metrics().Gather()
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Could not gather metrics: " + err.Error())
+		}
+		var body bytes.Buffer
+		format := expfmt.NewFormat(expfmt.TypeTextPlain)
+		encoder := expfmt.NewEncoder(&body, format)
+		for _, family := range families {
+			if err := encoder.Encode(family); err != nil {
+				return nil, huma.Error500InternalServerError("Could not encode metrics: " + err.Error())
+			}
+		}
+
+		return &MetricsOutput{ContentType: string(format), Body: body.Bytes()}, nil
+	})
+}
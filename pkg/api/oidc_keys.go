@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/internet-golf/internet-golf/pkg/utils"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+// the least amount of time between failed attempts to fetch an issuer's keys,
+// so that an outage (or a stream of tokens signed with keys that don't exist)
+// doesn't turn into a stream of requests to the issuer
+const oidcKeysRetryInterval = 30 * time.Second
+
+const oidcKeysFetchTimeout = 10 * time.Second
+
+type oidcKeySet struct {
+	mutex sync.Mutex
+	keys  jwk.Set
+	// when the keys were last fetched successfully
+	fetchedAt time.Time
+	// when the keys were last fetched, successfully or not
+	attemptedAt time.Time
+	refreshing  bool
+}
+
+// keeps the signing keys of each oidc issuer between requests. keys are
+// fetched again in the background once they're older than the refresh
+// interval, and if that fails, the old keys keep being used until the
+// fallback period runs out
+type oidcKeyCache struct {
+	mutex           sync.Mutex
+	sets            map[string]*oidcKeySet
+	refreshInterval time.Duration
+	fallback        time.Duration
+	metrics         *authMetrics
+}
+
+func newOidcKeyCache(config *utils.Config, metrics *authMetrics) *oidcKeyCache {
+	return &oidcKeyCache{
+		sets:            map[string]*oidcKeySet{},
+		refreshInterval: config.OidcKeysRefreshInterval,
+		fallback:        config.OidcKeysFallback,
+		metrics:         metrics,
+	}
+}
+
+func (c *oidcKeyCache) setFor(jwksUrl string) *oidcKeySet {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	set, ok := c.sets[jwksUrl]
+	if !ok {
+		set = &oidcKeySet{}
+		c.sets[jwksUrl] = set
+	}
+	return set
+}
+
+// returns the keys that tokens from the issuer can be verified with. keyId is
+// the id of the key that the token was signed with, if it has one; if the
+// cached keys don't have it, the issuer has probably rotated its keys, so
+// they're fetched again right away
+func (c *oidcKeyCache) keysFor(issuer utils.OidcIssuer, keyId string) (jwk.Set, error) {
+	set := c.setFor(issuer.JwksUrl)
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	age := time.Since(set.fetchedAt)
+	usable := set.keys != nil && age < c.refreshInterval+c.fallback
+	hasKey := set.keys != nil && (len(keyId) == 0 || hasKeyId(set.keys, keyId))
+	canRetry := time.Since(set.attemptedAt) >= min(oidcKeysRetryInterval, c.refreshInterval)
+
+	if hasKey && age < c.refreshInterval {
+		return set.keys, nil
+	}
+	if hasKey && usable {
+		if canRetry && !set.refreshing {
+			set.refreshing = true
+			set.attemptedAt = time.Now()
+			go c.refreshInBackground(issuer, set)
+		}
+		return set.keys, nil
+	}
+	if !canRetry {
+		if usable {
+			return set.keys, nil
+		}
+		return nil, fmt.Errorf("could not fetch signing keys for %s recently; try again soon", issuer.Name)
+	}
+
+	keys, err := c.fetch(issuer, set)
+	if err != nil {
+		if usable {
+			// the token will probably fail to verify, but that's better than
+			// failing because of the network
+			return set.keys, nil
+		}
+		return nil, fmt.Errorf("could not fetch signing keys for %s: %w", issuer.Name, err)
+	}
+	return keys, nil
+}
+
+// has to be called with the set's mutex locked
+func (c *oidcKeyCache) fetch(issuer utils.OidcIssuer, set *oidcKeySet) (jwk.Set, error) {
+	set.attemptedAt = time.Now()
+	keys, err := c.download(issuer)
+	if err != nil {
+		return nil, err
+	}
+	set.keys = keys
+	set.fetchedAt = time.Now()
+	return keys, nil
+}
+
+// the set's mutex isn't held while the keys are downloaded, so that requests
+// can keep using the old keys in the meantime
+func (c *oidcKeyCache) refreshInBackground(issuer utils.OidcIssuer, set *oidcKeySet) {
+	keys, err := c.download(issuer)
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	set.refreshing = false
+	if err != nil {
+		fmt.Printf(
+			"could not refresh signing keys for %s; using keys from %s until they're %s old: %v\n",
+			issuer.Name, set.fetchedAt.Format(time.RFC3339), c.refreshInterval+c.fallback, err,
+		)
+		return
+	}
+	set.keys = keys
+	set.fetchedAt = time.Now()
+}
+
+func (c *oidcKeyCache) download(issuer utils.OidcIssuer) (jwk.Set, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), oidcKeysFetchTimeout)
+	defer cancel()
+	keys, err := jwk.Fetch(ctx, issuer.JwksUrl)
+	if err != nil {
+		c.metrics.keyFetchFailures.WithLabelValues(issuer.Name).Inc()
+		return nil, err
+	}
+	return keys, nil
+}
+
+func hasKeyId(keys jwk.Set, keyId string) bool {
+	_, found := keys.LookupKeyID(keyId)
+	return found
+}
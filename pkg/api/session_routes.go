@@ -0,0 +1,321 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/internet-golf/internet-golf/pkg/db"
+)
+
+// operations with this in their metadata are let through by readAuth without
+// any credentials
+const skipAuthMetadata = "skipAuth"
+
+// these are variables so that tests can point them somewhere else
+var (
+	githubDeviceCodeUrl  = "https://github.com/login/device/code"
+	githubAccessTokenUrl = "https://github.com/login/oauth/access_token"
+	githubUserUrl        = "https://api.github.com/user"
+)
+
+type SessionModel struct {
+	Identity  string `json:"identity" doc:"Who the session belongs to."`
+	CsrfToken string `json:"csrfToken,omitempty" required:"false" doc:"Has to be sent in the X-Golf-CSRF header with every request that uses the session cookie, besides GET requests."`
+	ExpiresAt string `json:"expiresAt,omitempty" required:"false" format:"date-time" doc:"When the session will stop working."`
+}
+
+type LoginInput struct {
+	ForwardedProto string `header:"X-Forwarded-Proto"`
+	Body           struct {
+		Token string `json:"token" doc:"A bearer token, like one from \"golf create-token\"."`
+	}
+}
+
+type LoginOutput struct {
+	SetCookie http.Cookie `header:"Set-Cookie"`
+	Body      SessionModel
+}
+
+type GetSessionInput struct {
+	SessionCookie string `cookie:"golf_session"`
+}
+
+type GetSessionOutput struct {
+	Body SessionModel
+}
+
+type LogoutInput struct {
+	SessionCookie  string `cookie:"golf_session"`
+	ForwardedProto string `header:"X-Forwarded-Proto"`
+}
+
+type LogoutOutput struct {
+	SetCookie http.Cookie `header:"Set-Cookie"`
+	Body      struct {
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+	}
+}
+
+type StartGithubLoginOutput struct {
+	Body struct {
+		DeviceCode      string `json:"deviceCode" doc:"Has to be sent to the finish endpoint once the user has entered the user code."`
+		UserCode        string `json:"userCode" doc:"The code that the user has to enter at the verification URI."`
+		VerificationUri string `json:"verificationUri"`
+		ExpiresIn       int    `json:"expiresIn" doc:"How many seconds the codes are good for."`
+		Interval        int    `json:"interval" doc:"How many seconds to wait between calls to the finish endpoint."`
+	}
+}
+
+type FinishGithubLoginInput struct {
+	ForwardedProto string `header:"X-Forwarded-Proto"`
+	Body           struct {
+		DeviceCode string `json:"deviceCode"`
+	}
+}
+
+type FinishGithubLoginOutput struct {
+	// a string instead of an http.Cookie, so that no header is sent while the
+	// login is pending
+	SetCookie string `header:"Set-Cookie"`
+	Body      struct {
+		Pending bool `json:"pending" doc:"Whether the user still hasn't entered the user code. If so, this endpoint should be called again after the interval."`
+		SessionModel
+	}
+}
+
+// the session cookie is only sent to the admin api, and only over https, unless
+// the request was made over plain http (like when running with --local)
+func (a *AdminApi) sessionCookie(secret string, expiresAt time.Time, forwardedProto string) http.Cookie {
+	cookie := http.Cookie{
+		Name:     SessionCookieName,
+		Value:    secret,
+		Path:     a.config.AdminApiPath,
+		HttpOnly: true,
+		Secure:   forwardedProto != "http",
+		SameSite: http.SameSiteStrictMode,
+	}
+	if expiresAt.IsZero() {
+		cookie.MaxAge = -1
+	} else {
+		cookie.Expires = expiresAt
+		cookie.MaxAge = int(time.Until(expiresAt).Seconds())
+	}
+	return cookie
+}
+
+func sessionToApiModel(secret string, session db.Session) SessionModel {
+	return SessionModel{
+		Identity:  session.Identity,
+		CsrfToken: CsrfTokenForSession(secret),
+		ExpiresAt: session.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+func authErrorToHuma(err error) error {
+	var authErr *AuthError
+	if errors.As(err, &authErr) {
+		if authErr.RetryAfter > 0 {
+			return huma.ErrorWithHeaders(
+				huma.NewError(authErr.Status, err.Error()),
+				http.Header{"Retry-After": {strconv.Itoa(int(authErr.RetryAfter.Seconds()) + 1)}},
+			)
+		}
+		return huma.NewError(authErr.Status, err.Error())
+	}
+	return huma.Error500InternalServerError("Could not start session: " + err.Error())
+}
+
+func (a *AdminApi) addSessionRoutes(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "Login",
+		Description: "Start an admin dashboard session with a bearer token. The session is kept in an HTTP-only cookie.",
+		Method:      http.MethodPost,
+		Path:        "/session/login",
+		Metadata:    map[string]any{skipAuthMetadata: true},
+	}, func(ctx context.Context, input *LoginInput) (*LoginOutput, error) {
+		remoteAddr, _ := ctx.Value("remoteAddr").(string)
+		secret, session, err := a.auth.LoginWithToken(remoteAddr, input.Body.Token)
+		if err != nil {
+			return nil, authErrorToHuma(err)
+		}
+		// logging in doesn't go through readAuth, so there aren't any
+		// permissions for the audit log to get the identity from
+		auditDetailsFrom(ctx).Actor = session.Identity
+		return &LoginOutput{
+			SetCookie: a.sessionCookie(secret, session.ExpiresAt, input.ForwardedProto),
+			Body:      sessionToApiModel(secret, session),
+		}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "GetSession",
+		Description: "Find out who the request is authenticated as. The CSRF token is included if the request used a session cookie.",
+		Method:      http.MethodGet,
+		Path:        "/session",
+	}, func(ctx context.Context, input *GetSessionInput) (*GetSessionOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		var output GetSessionOutput
+		output.Body.Identity = permissions.Identity()
+		if _, isSession := permissions.(*SessionAuthChecker); isSession {
+			session, err := a.auth.GetSession(input.SessionCookie)
+			if err == nil {
+				output.Body = sessionToApiModel(input.SessionCookie, session)
+			}
+		}
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "Logout",
+		Description: "End the admin dashboard session that the request's cookie is for.",
+		Method:      http.MethodPost,
+		Path:        "/session/logout",
+	}, func(ctx context.Context, input *LogoutInput) (*LogoutOutput, error) {
+		if len(input.SessionCookie) == 0 {
+			return nil, huma.Error400BadRequest("The request didn't have a session cookie")
+		}
+		if err := a.auth.EndSession(input.SessionCookie); err != nil {
+			return nil, huma.Error500InternalServerError("Could not end session: " + err.Error())
+		}
+		output := LogoutOutput{SetCookie: a.sessionCookie("", time.Time{}, input.ForwardedProto)}
+		output.Body.Success = true
+		output.Body.Message = "Logged out"
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "StartGithubLogin",
+		Description: "Start logging into the admin dashboard as a registered Github user with Github's device flow. " +
+			"The user has to enter the user code at the verification URI, and then the finish endpoint can be called with the device code.",
+		Method:   http.MethodPost,
+		Path:     "/session/github/start",
+		Metadata: map[string]any{skipAuthMetadata: true, skipAuditMetadata: true},
+	}, func(ctx context.Context, input *struct{}) (*StartGithubLoginOutput, error) {
+		if len(a.config.GithubOAuthClientId) == 0 {
+			return nil, huma.Error501NotImplemented("This server doesn't have a Github OAuth app set up")
+		}
+		var response struct {
+			DeviceCode      string `json:"device_code"`
+			UserCode        string `json:"user_code"`
+			VerificationUri string `json:"verification_uri"`
+			ExpiresIn       int    `json:"expires_in"`
+			Interval        int    `json:"interval"`
+		}
+		err := postGithubForm(githubDeviceCodeUrl, url.Values{"client_id": {a.config.GithubOAuthClientId}}, &response)
+		if err != nil || len(response.DeviceCode) == 0 {
+			return nil, huma.Error502BadGateway(fmt.Sprintf("Could not start the Github device flow: %v", err))
+		}
+		var output StartGithubLoginOutput
+		output.Body.DeviceCode = response.DeviceCode
+		output.Body.UserCode = response.UserCode
+		output.Body.VerificationUri = response.VerificationUri
+		output.Body.ExpiresIn = response.ExpiresIn
+		output.Body.Interval = response.Interval
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "FinishGithubLogin",
+		Description: "Finish logging in with Github's device flow. If the user hasn't entered the user code yet, the response says that the login is pending.",
+		Method:      http.MethodPost,
+		Path:        "/session/github/finish",
+		Metadata:    map[string]any{skipAuthMetadata: true},
+	}, func(ctx context.Context, input *FinishGithubLoginInput) (*FinishGithubLoginOutput, error) {
+		if len(a.config.GithubOAuthClientId) == 0 {
+			return nil, huma.Error501NotImplemented("This server doesn't have a Github OAuth app set up")
+		}
+		var tokenResponse struct {
+			AccessToken string `json:"access_token"`
+			Error       string `json:"error"`
+		}
+		err := postGithubForm(githubAccessTokenUrl, url.Values{
+			"client_id":   {a.config.GithubOAuthClientId},
+			"device_code": {input.Body.DeviceCode},
+			"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		}, &tokenResponse)
+		if err != nil {
+			return nil, huma.Error502BadGateway("Could not reach Github: " + err.Error())
+		}
+		var output FinishGithubLoginOutput
+		switch tokenResponse.Error {
+		case "":
+		case "authorization_pending", "slow_down":
+			output.Body.Pending = true
+			auditDetailsFrom(ctx).Skip = true
+			return &output, nil
+		default:
+			return nil, huma.Error401Unauthorized("Github login failed: " + tokenResponse.Error)
+		}
+
+		userId, login, err := getGithubUser(tokenResponse.AccessToken)
+		if err != nil {
+			return nil, huma.Error502BadGateway("Could not get the Github user: " + err.Error())
+		}
+		auditDetailsFrom(ctx).Actor = "github:" + login
+		secret, session, err := a.auth.LoginWithGithubUser(userId, login)
+		if err != nil {
+			return nil, authErrorToHuma(err)
+		}
+		cookie := a.sessionCookie(secret, session.ExpiresAt, input.ForwardedProto)
+		output.SetCookie = cookie.String()
+		output.Body.SessionModel = sessionToApiModel(secret, session)
+		return &output, nil
+	})
+}
+
+func postGithubForm(endpoint string, form url.Values, response any) error {
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("got status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// returns the id and login of the user that the oauth token belongs to
+func getGithubUser(accessToken string) (string, string, error) {
+	req, err := http.NewRequest(http.MethodGet, githubUserUrl, nil)
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("got status %d", resp.StatusCode)
+	}
+	var user struct {
+		Id    int64  `json:"id"`
+		Login string `json:"login"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil || user.Id == 0 {
+		return "", "", fmt.Errorf("could not parse user: %v", err)
+	}
+	return strconv.FormatInt(user.Id, 10), user.Login, nil
+}
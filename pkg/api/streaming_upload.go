@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humago"
+	"github.com/internet-golf/internet-golf/pkg/utils"
+)
+
+// the request body for uploading files isn't parsed by huma, since huma saves
+// the whole form (including the uploaded file) before the route handler gets
+// to see any of it. instead, the body is captured here and read in the route
+// handler as it streams in (see readFields)
+func (i *DeployFilesInput) Resolve(ctx huma.Context) []error {
+	if timeout := ctx.Operation().BodyReadTimeout; timeout > 0 {
+		ctx.SetReadDeadline(time.Now().Add(timeout))
+	}
+	i.contentType = ctx.Header("Content-Type")
+	i.contentLength, _ = strconv.ParseInt(ctx.Header("Content-Length"), 10, 64)
+	i.body = ctx.BodyReader()
+	return nil
+}
+
+// reads the form fields that come before the "contents" file and returns them,
+// along with a reader for the file. fields that come after the file are
+// ignored, since reading them would mean reading past the file first
+func (i *DeployFilesInput) readFields(maxUploadSize int64) (DeployFilesBody, io.Reader, error) {
+	var fields DeployFilesBody
+	mediaType, params, err := mime.ParseMediaType(i.contentType)
+	if err != nil || mediaType != "multipart/form-data" || len(params["boundary"]) == 0 {
+		return fields, nil, huma.Error415UnsupportedMediaType("Expected a multipart/form-data request body")
+	}
+	if maxUploadSize > 0 && i.contentLength > maxUploadSize {
+		return fields, nil, uploadTooLargeError(maxUploadSize)
+	}
+	if maxUploadSize > 0 {
+		i.limitedBody = &limitedBody{reader: i.body, remaining: maxUploadSize}
+		i.body = i.limitedBody
+	}
+
+	form := multipart.NewReader(i.body, params["boundary"])
+	for {
+		part, err := form.NextPart()
+		if err == io.EOF {
+			return fields, nil, huma.Error400BadRequest("contents is required")
+		}
+		if err != nil {
+			if i.uploadTooLarge() {
+				return fields, nil, uploadTooLargeError(maxUploadSize)
+			}
+			return fields, nil, huma.Error400BadRequest("Could not read form: " + err.Error())
+		}
+
+		name := part.FormName()
+		if name == "contents" {
+			if len(fields.Url) == 0 {
+				return fields, nil, huma.Error400BadRequest("url is required, and has to come before contents")
+			}
+			return fields, part, nil
+		}
+
+		// regular fields are small, so anything big is probably a mistake
+		value, err := io.ReadAll(io.LimitReader(part, 64*1024))
+		if err != nil {
+			return fields, nil, huma.Error400BadRequest("Could not read form field " + name)
+		}
+		switch name {
+		case "url":
+			fields.Url = string(value)
+		case "keepLeadingDirectories", "preserveExistingFiles":
+			parsed, err := strconv.ParseBool(string(value))
+			if err != nil {
+				return fields, nil, huma.Error400BadRequest(name + " has to be true or false")
+			}
+			if name == "keepLeadingDirectories" {
+				fields.KeepLeadingDirectories = parsed
+			} else {
+				fields.PreserveExistingFiles = parsed
+			}
+		case "delete":
+			fields.Delete = append(fields.Delete, string(value))
+		}
+	}
+}
+
+// whether the upload was cut off for being bigger than the maximum size.
+// reading from the body can fail in all sorts of places (like in the middle of
+// extracting an archive), which don't always pass the original error on, so
+// this is the reliable way to find out
+func (i *DeployFilesInput) uploadTooLarge() bool {
+	return i.limitedBody != nil && i.limitedBody.exceeded
+}
+
+func uploadTooLargeError(maxUploadSize int64) error {
+	return huma.NewError(
+		http.StatusRequestEntityTooLarge,
+		fmt.Sprintf("Uploads can't be bigger than %d bytes", maxUploadSize),
+	)
+}
+
+var errUploadTooLarge = errors.New("upload is bigger than the maximum size")
+
+// like io.LimitReader, except that going over the limit is an error instead of
+// looking like the end of the stream, so a cut-off upload can't be mistaken for
+// a complete one
+type limitedBody struct {
+	reader    io.Reader
+	remaining int64
+	exceeded  bool
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	if l.exceeded {
+		return 0, errUploadTooLarge
+	}
+	// one byte past the limit is allowed through so that a body that's
+	// exactly at the limit can still reach EOF
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.reader.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		l.exceeded = true
+		return 0, errUploadTooLarge
+	}
+	return n, err
+}
+
+// how long clients get to send an upload. without a deadline, a client that
+// stops sending in the middle of an upload would keep its connection (and
+// whatever it had sent so far) around forever
+const uploadReadTimeout = 15 * time.Minute
+
+// operations with this in their metadata have their multipart form parsed by
+// huma, but with the request body limited to the maximum upload size (see
+// limitUploadBodies and readLimitedForm)
+const limitedUploadMetadata = "limitedUpload"
+
+// returns a huma middleware function that cuts off the request body at
+// maxUploadSize for operations with limitedUploadMetadata. huma doesn't apply
+// MaxBodyBytes to multipart forms, and it saves the whole form to disk before
+// the route handler can check how big anything in it is. this has to be the
+// first middleware, since the request can only be gotten at before other
+// middleware wraps the context
+func limitUploadBodies(maxUploadSize int64) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		if op := ctx.Operation(); maxUploadSize > 0 && op != nil && op.Metadata[limitedUploadMetadata] == true {
+			r, w := humago.Unwrap(ctx)
+			r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+		}
+		next(ctx)
+	}
+}
+
+// returns a huma middleware function for operations with limitedUploadMetadata
+// that reads the form ahead of huma (which then uses the already-parsed form),
+// so that a body that's over the limit can be reported as such instead of as a
+// malformed form. this is an operation middleware so that it runs after
+// readAuth, since there's no point in reading uploads from just anyone
+func readLimitedForm(api huma.API, config *utils.Config) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		if timeout := ctx.Operation().BodyReadTimeout; timeout > 0 {
+			ctx.SetReadDeadline(time.Now().Add(timeout))
+		}
+		// other problems with the form are left for huma to report
+		_, err := ctx.GetMultipartForm()
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			huma.WriteErr(
+				api, ctx, http.StatusRequestEntityTooLarge,
+				uploadTooLargeError(config.MaxUploadSize).Error(),
+			)
+			return
+		}
+		next(ctx)
+	}
+}
+
+// huma can only describe a multipart form in the OpenAPI spec if it's the one
+// parsing the form, so the description of the form is borrowed from an
+// operation on a throwaway API that does let huma parse it
+func multipartRequestBody[T any]() *huma.RequestBody {
+	scratch := humago.New(http.NewServeMux(), huma.DefaultConfig("", ""))
+	huma.Put(scratch, "/", func(
+		ctx context.Context, input *struct {
+			RawBody huma.MultipartFormFiles[T]
+		},
+	) (*struct{}, error) {
+		return nil, nil
+	})
+	return scratch.OpenAPI().Paths["/"].Put.RequestBody
+}
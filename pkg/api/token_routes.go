@@ -0,0 +1,236 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/internet-golf/internet-golf/pkg/db"
+)
+
+type CreateBearerTokenBody struct {
+	Name            string       `json:"name,omitempty" required:"false" doc:"Name for the token, to help tell it apart from the others. This is just metadata."`
+	FullPermissions bool         `json:"fullPermissions" doc:"Whether the token can do anything, including creating more credentials. If this is false, the token can only do what its scopes allow."`
+	Scopes          []ScopeModel `json:"scopes,omitempty" required:"false" doc:"What the token can do, if it doesn't have full permissions."`
+	ExpiresIn       string       `json:"expiresIn,omitempty" required:"false" doc:"How long until the token stops working, like \"720h\". If this isn't set, the token works until it's revoked." example:"720h"`
+}
+
+type CreateBearerTokenInput struct {
+	Body struct {
+		CreateBearerTokenBody
+	}
+}
+
+type CreateBearerTokenOutput struct {
+	Body struct {
+		Id    string `json:"id" doc:"ID of the token, which can be used to revoke or rotate it. This is also the part of the token before the period."`
+		Token string `json:"token"`
+	}
+}
+
+type BearerTokenModel struct {
+	Id              string       `json:"id"`
+	Name            string       `json:"name,omitempty"`
+	FullPermissions bool         `json:"fullPermissions"`
+	Scopes          []ScopeModel `json:"scopes,omitempty"`
+	CreatedAt       string       `json:"createdAt" doc:"When the token was created (string in ISO-8601 format.)"`
+	ExpiresAt       string       `json:"expiresAt,omitempty" doc:"When the token stops working, if it ever does (string in ISO-8601 format.)"`
+	LastUsedAt      string       `json:"lastUsedAt,omitempty" doc:"Roughly when the token was last used, if it has been (string in ISO-8601 format.)"`
+	RevokedAt       string       `json:"revokedAt,omitempty" doc:"When the token was revoked, if it has been (string in ISO-8601 format.)"`
+	RotatedAt       string       `json:"rotatedAt,omitempty" doc:"When the token was last rotated, if it has been (string in ISO-8601 format.)"`
+}
+
+type GetBearerTokensOutput struct {
+	Body struct {
+		Tokens []BearerTokenModel `json:"tokens"`
+	}
+}
+
+type RotateBearerTokenInput struct {
+	Id   string `path:"id"`
+	Body struct {
+		ExpiresIn string `json:"expiresIn,omitempty" required:"false" doc:"How long until the rotated token stops working, like \"720h\". If this isn't set, the token keeps its current expiry." example:"720h"`
+	}
+}
+
+// parses a duration like "720h" into the time that far in the future, or
+// returns the zero time for an empty string
+func expiryFromDuration(expiresIn string) (time.Time, error) {
+	if len(expiresIn) == 0 {
+		return time.Time{}, nil
+	}
+	duration, err := time.ParseDuration(expiresIn)
+	if err != nil || duration <= 0 {
+		return time.Time{}, fmt.Errorf("invalid expiry \"%s\"", expiresIn)
+	}
+	return time.Now().Add(duration), nil
+}
+
+func bearerTokenToApiModel(token db.BearerToken) BearerTokenModel {
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	model := BearerTokenModel{
+		Id:              token.Id,
+		Name:            token.Name,
+		FullPermissions: token.FullPermissions,
+		CreatedAt:       formatTime(token.CreatedAt),
+		ExpiresAt:       formatTime(token.ExpiresAt),
+		LastUsedAt:      formatTime(token.LastUsedAt),
+		RevokedAt:       formatTime(token.RevokedAt),
+		RotatedAt:       formatTime(token.RotatedAt),
+	}
+	for _, scope := range token.Scopes {
+		scopeModel := ScopeModel{Urls: scope.Urls, UrlPrefixes: scope.UrlPrefixes, Tags: scope.Tags}
+		for _, action := range scope.Actions {
+			scopeModel.Actions = append(scopeModel.Actions, string(action))
+		}
+		model.Scopes = append(model.Scopes, scopeModel)
+	}
+	return model
+}
+
+func (a *AdminApi) addTokenRoutes(api huma.API) {
+	huma.Post(api, "/token/generate", func(ctx context.Context, input *CreateBearerTokenInput) (*CreateBearerTokenOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		bootstrapping := !permissions.CanCreateCredentials() && permissions.CanBootstrapToken()
+		if !permissions.CanCreateCredentials() && !bootstrapping {
+			return nil, huma.Error403Forbidden("Not authorized to create tokens")
+		}
+
+		scopes, err := scopesFromModels(input.Body.Scopes)
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+		if !input.Body.FullPermissions && len(scopes) == 0 {
+			return nil, huma.Error400BadRequest("Tokens need either full permissions or at least one scope")
+		}
+		expiresAt, err := expiryFromDuration(input.Body.ExpiresIn)
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
+		newToken := db.BearerToken{
+			Name:            input.Body.Name,
+			FullPermissions: input.Body.FullPermissions,
+			Scopes:          scopes,
+			ExpiresAt:       expiresAt,
+		}
+		var token string
+		if bootstrapping {
+			token, err = a.auth.CreateFirstBearerToken(newToken)
+		} else {
+			token, err = a.auth.CreateBearerToken(newToken)
+		}
+		if errors.Is(err, db.ErrBearerTokensExist) {
+			return nil, huma.Error403Forbidden("A token has already been created; use it to create more")
+		}
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Could not generate token: " + err.Error())
+		}
+
+		var output CreateBearerTokenOutput
+		output.Body.Id, _, _ = strings.Cut(token, ".")
+		output.Body.Token = token
+		auditDetailsFrom(ctx).Target = "token " + output.Body.Id
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "GetTokens",
+		Description: "List the bearer tokens that have been created, without their secrets.",
+		Method:      http.MethodGet,
+		Path:        "/tokens",
+	}, func(ctx context.Context, input *struct{}) (*GetBearerTokensOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+
+		if !permissions.CanCreateCredentials() {
+			return nil, huma.Error403Forbidden("Not authorized to view tokens")
+		}
+
+		tokens, err := a.auth.GetBearerTokens()
+		if err != nil {
+			return nil, huma.Error500InternalServerError(err.Error())
+		}
+
+		var output GetBearerTokensOutput
+		output.Body.Tokens = []BearerTokenModel{}
+		for _, token := range tokens {
+			output.Body.Tokens = append(output.Body.Tokens, bearerTokenToApiModel(token))
+		}
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "RevokeToken",
+		Description: "Revoke a bearer token, so that it stops working.",
+		Method:      http.MethodDelete,
+		Path:        "/token/{id}",
+	}, func(ctx context.Context, input *struct {
+		Id string `path:"id"`
+	}) (*SuccessOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+		auditDetailsFrom(ctx).Target = "token " + input.Id
+
+		if !permissions.CanCreateCredentials() {
+			return nil, huma.Error403Forbidden("Not authorized to revoke tokens")
+		}
+
+		if err := a.auth.RevokeBearerToken(input.Id); err != nil {
+			return nil, huma.Error404NotFound(err.Error())
+		}
+
+		var output SuccessOutput
+		output.Body.Success = true
+		output.Body.Message = fmt.Sprintf("Revoked token %s", input.Id)
+		return &output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "RotateToken",
+		Description: "Replace the secret part of a bearer token. The old value stops working right away; the token keeps its ID, name, and permissions.",
+		Method:      http.MethodPost,
+		Path:        "/token/{id}/rotate",
+	}, func(ctx context.Context, input *RotateBearerTokenInput) (*CreateBearerTokenOutput, error) {
+		permissions, permissionsOk := ctx.Value("permissions").(Permissions)
+		if !permissionsOk {
+			return nil, huma.Error500InternalServerError("Auth check failed somehow")
+		}
+		auditDetailsFrom(ctx).Target = "token " + input.Id
+
+		if !permissions.CanCreateCredentials() {
+			return nil, huma.Error403Forbidden("Not authorized to rotate tokens")
+		}
+
+		expiresAt, err := expiryFromDuration(input.Body.ExpiresIn)
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+		token, err := a.auth.RotateBearerToken(input.Id, expiresAt)
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
+		var output CreateBearerTokenOutput
+		output.Body.Id = input.Id
+		output.Body.Token = token
+		return &output, nil
+	})
+}
@@ -1,20 +1,36 @@
 package db
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/asdine/storm/v3"
+	"github.com/asdine/storm/v3/q"
 	"github.com/internet-golf/internet-golf/pkg/resources"
 	"github.com/internet-golf/internet-golf/pkg/utils"
+	bolt "go.etcd.io/bbolt"
 )
 
 type Db interface {
-	SaveDeployments(d []Deployment) error
+	SaveDeployments(deployments []Deployment) error
 	GetDeployments() ([]Deployment, error)
+	DeleteDeployment(url Url) error
 	SaveExternalUser(u ExternalUser) error
-	GetExternalUser(externalId string) (ExternalUser, error)
+	GetExternalUser(source ExternalSourceType, externalId string) (ExternalUser, error)
 	SaveBearerToken(b BearerToken) error
+	// saves the token only if there aren't any tokens yet, and returns
+	// ErrBearerTokensExist otherwise
+	SaveFirstBearerToken(b BearerToken) error
 	GetBearerToken(string) (BearerToken, error)
+	GetBearerTokens() ([]BearerToken, error)
+	SaveSession(session Session) error
+	GetSession(id string) (Session, error)
+	DeleteSession(id string) error
+	AddAuditEntry(entry AuditEntry) error
+	GetAuditEntries(filter AuditFilter) ([]AuditEntry, error)
+	Close() error
 }
 
 // i found the database package "storm" on github and didn't realize until after
@@ -22,130 +38,225 @@ type Db interface {
 // in 5 years. i guess it's fine??? implements the `Db` interface.
 type StormDb struct {
 	config *utils.Config
-	dbFile string
+	// the database file stays open (and locked, so that only one server can
+	// use it at a time) until Close is called
+	db *storm.DB
 }
 
+// how long to wait for another process to let go of the database file before
+// giving up
+const dbLockTimeout = 5 * time.Second
+
 func NewDb(config *utils.Config, files *resources.FileManager) (Db, error) {
 	dbFile := files.DbPath
 
-	storm, stormOpenErr := storm.Open(dbFile)
+	db, stormOpenErr := storm.Open(
+		dbFile, storm.BoltOptions(0600, &bolt.Options{Timeout: dbLockTimeout}),
+	)
+	if errors.Is(stormOpenErr, bolt.ErrTimeout) {
+		return nil, fmt.Errorf("%s is being used by something else (maybe another golf server?)", dbFile)
+	}
 	if stormOpenErr != nil {
 		return nil, stormOpenErr
 	}
-	defer storm.Close()
 
-	deploymentBucketErr := storm.Init(&Deployment{})
+	deploymentBucketErr := db.Init(&Deployment{})
 	if deploymentBucketErr != nil {
+		db.Close()
 		return nil, fmt.Errorf("Error creating deployment bucket: %+v", deploymentBucketErr)
 	}
 
-	usersBucketErr := storm.Init(&ExternalUser{})
+	usersBucketErr := db.Init(&ExternalUser{})
 	if usersBucketErr != nil {
+		db.Close()
 		return nil, fmt.Errorf("Error creating users bucket: %+v", usersBucketErr)
 	}
 
-	// create and return object that implements Db
-
-	db := &StormDb{
-		config: config,
-		dbFile: dbFile,
-	}
+	return &StormDb{config: config, db: db}, nil
+}
 
-	return db, nil
+func (s *StormDb) Close() error {
+	return s.db.Close()
 }
 
 func (s *StormDb) GetStorageDirectory() string {
 	return s.config.DataDirectory
 }
 
-// i am sort of not sure why i open and close the database in each and every one
-// of these methods, instead of keeping it open
-
 func (s *StormDb) GetDeployments() ([]Deployment, error) {
-	db, dbOpenErr := storm.Open(s.dbFile)
-	if dbOpenErr != nil {
-		return nil, dbOpenErr
-	}
-	defer db.Close()
-
 	var existingDeployments []Deployment
-	loadingExistingErr := db.All(&existingDeployments)
+	loadingExistingErr := s.db.All(&existingDeployments)
 	if loadingExistingErr != nil {
 		return nil, loadingExistingErr
 	}
 	return existingDeployments, nil
 }
 
-func (s *StormDb) SaveDeployments(d []Deployment) error {
-	db, dbOpenErr := storm.Open(s.dbFile)
-	if dbOpenErr != nil {
-		return dbOpenErr
+// saves all of the deployments in one transaction, so either all of them are
+// saved or none of them are
+func (s *StormDb) SaveDeployments(deployments []Deployment) error {
+	tx, err := s.db.Begin(true)
+	if err != nil {
+		return err
 	}
-	defer db.Close()
+	defer tx.Rollback()
 
-	for _, d := range d {
+	for _, d := range deployments {
 		if !d.DontPersist {
-			saveErr := db.Save(&d)
-			if saveErr != nil {
-				fmt.Printf(
-					"could not save deployment %s: %+v\n",
-					d.Url, saveErr,
-				)
+			if err := tx.Save(&d); err != nil {
+				return fmt.Errorf("could not save deployment %s: %w", d.Url, err)
 			}
 		}
 	}
 
-	return nil
+	return tx.Commit()
 }
 
-func (s *StormDb) SaveExternalUser(u ExternalUser) error {
-	db, dbOpenErr := storm.Open(s.dbFile)
-	if dbOpenErr != nil {
-		return dbOpenErr
+// deleting a deployment that was never saved (like one with DontPersist) is
+// not an error
+func (s *StormDb) DeleteDeployment(url Url) error {
+	err := s.db.DeleteStruct(&Deployment{DeploymentMetadata: DeploymentMetadata{Url: url}})
+	if errors.Is(err, storm.ErrNotFound) {
+		return nil
 	}
-	defer db.Close()
-
-	return db.Save(&u)
+	return err
 }
 
-func (s *StormDb) GetExternalUser(externalId string) (ExternalUser, error) {
-	db, dbOpenErr := storm.Open(s.dbFile)
-	if dbOpenErr != nil {
-		return ExternalUser{}, dbOpenErr
+// users from different sources can have the same id, so users from sources
+// other than github are stored under their source and id together. github
+// users are stored under just their id, since that's how they were stored
+// before there were other sources
+func externalUserKey(source ExternalSourceType, externalId string) string {
+	if source == Github || len(source) == 0 {
+		return externalId
 	}
-	defer db.Close()
+	return string(source) + ":" + externalId
+}
 
+func (s *StormDb) SaveExternalUser(u ExternalUser) error {
+	u.ExternalId = externalUserKey(u.ExternalSource, u.ExternalId)
+	return s.db.Save(&u)
+}
+
+func (s *StormDb) GetExternalUser(source ExternalSourceType, externalId string) (ExternalUser, error) {
 	var result ExternalUser
-	err := db.Get("ExternalUser", externalId, &result)
+	err := s.db.Get("ExternalUser", externalUserKey(source, externalId), &result)
 	if err != nil {
 		return ExternalUser{}, err
 	}
+	// users saved before there were other sources don't have one
+	if len(result.ExternalSource) == 0 {
+		result.ExternalSource = Github
+	}
+	if result.ExternalSource != source {
+		return ExternalUser{}, fmt.Errorf("could not find %s user %s", source, externalId)
+	}
+	result.ExternalId = externalId
 
 	return result, nil
 }
 
 func (s *StormDb) SaveBearerToken(token BearerToken) error {
-	db, dbOpenErr := storm.Open(s.dbFile)
-	if dbOpenErr != nil {
-		return dbOpenErr
+	return s.db.Save(&token)
+}
+
+var ErrBearerTokensExist = errors.New("a bearer token has already been created")
+
+// the check and the save happen in the same transaction, so that two requests
+// can't both create the first token
+func (s *StormDb) SaveFirstBearerToken(token BearerToken) error {
+	tx, err := s.db.Begin(true)
+	if err != nil {
+		return err
 	}
-	defer db.Close()
+	defer tx.Rollback()
 
-	return db.Save(&token)
+	count, err := tx.Count(&BearerToken{})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrBearerTokensExist
+	}
+	if err := tx.Save(&token); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (s *StormDb) GetBearerToken(id string) (BearerToken, error) {
-	db, dbOpenErr := storm.Open(s.dbFile)
-	if dbOpenErr != nil {
-		return BearerToken{}, dbOpenErr
-	}
-	defer db.Close()
-
 	var result BearerToken
-	err := db.Get("BearerToken", id, &result)
+	err := s.db.Get("BearerToken", id, &result)
 	if err != nil {
 		return BearerToken{}, err
 	}
 
 	return result, nil
 }
+
+func (s *StormDb) GetBearerTokens() ([]BearerToken, error) {
+	var tokens []BearerToken
+	err := s.db.All(&tokens)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (s *StormDb) SaveSession(session Session) error {
+	return s.db.Save(&session)
+}
+
+func (s *StormDb) GetSession(id string) (Session, error) {
+	var result Session
+	err := s.db.Get("Session", id, &result)
+	if err != nil {
+		return Session{}, err
+	}
+
+	return result, nil
+}
+
+func (s *StormDb) DeleteSession(id string) error {
+	return s.db.DeleteStruct(&Session{Id: id})
+}
+
+func (s *StormDb) AddAuditEntry(entry AuditEntry) error {
+	// the id is assigned by storm, so that entries can't overwrite each other
+	entry.Id = 0
+	return s.db.Save(&entry)
+}
+
+// returns the entries that match the filter, newest first
+func (s *StormDb) GetAuditEntries(filter AuditFilter) ([]AuditEntry, error) {
+	matchers := []q.Matcher{}
+	if len(filter.Actor) > 0 {
+		matchers = append(matchers, q.Re("Actor", regexp.QuoteMeta(filter.Actor)))
+	}
+	if len(filter.Action) > 0 {
+		matchers = append(matchers, q.Eq("Action", filter.Action))
+	}
+	if len(filter.Target) > 0 {
+		matchers = append(matchers, q.Eq("Target", filter.Target))
+	}
+	if len(filter.Outcome) > 0 {
+		matchers = append(matchers, q.Eq("Outcome", filter.Outcome))
+	}
+	if !filter.Since.IsZero() {
+		matchers = append(matchers, q.Gte("Time", filter.Since))
+	}
+	if !filter.Until.IsZero() {
+		matchers = append(matchers, q.Lte("Time", filter.Until))
+	}
+
+	query := s.db.Select(matchers...).OrderBy("Id").Reverse()
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	entries := []AuditEntry{}
+	err := query.Find(&entries)
+	if err != nil && !errors.Is(err, storm.ErrNotFound) {
+		return nil, err
+	}
+	return entries, nil
+}
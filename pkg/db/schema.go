@@ -12,33 +12,193 @@ const (
 	StaticFiles ServedThingType = "StaticFiles"
 	Alias       ServedThingType = "Alias"
 
-	// not implemented yet
+	// a docker container started by the server and reverse-proxied to
 	DockerContainer ServedThingType = "DockerContainer"
+	// an executable uploaded to the server and run as a child process of it
+	NativeProcess ServedThingType = "NativeProcess"
 	// low-level deployment type; currently just used to expose the admin api
 	ReverseProxy ServedThingType = "ReverseProxy"
 )
 
+// where a repo or an external user lives. any name works as long as the server
+// has an oidc issuer with that name configured; github is always configured
 type ExternalSourceType string
 
 const (
-	Github ExternalSourceType = "Github"
+	Github  ExternalSourceType = "Github"
+	Gitlab  ExternalSourceType = "Gitlab"
+	Forgejo ExternalSourceType = "Forgejo"
 )
 
+// something that can be done with a deployment
+type Action string
+
+const (
+	ViewAction Action = "view"
+	// put new content in the deployment, or roll it back to old content
+	DeployAction Action = "deploy"
+	// change the deployment's settings
+	ModifyAction Action = "modify"
+	DeleteAction Action = "delete"
+)
+
+// a set of deployments and the actions that can be done with them. a
+// deployment is in the set if its url is one of the Urls, if it starts with one
+// of the UrlPrefixes, or if it has one of the Tags. any of the actions also
+// lets the deployment be viewed
+type Scope struct {
+	Urls        []string
+	UrlPrefixes []string
+	Tags        []string
+	Actions     []Action
+}
+
 type ExternalUser struct {
 	ExternalId      string `storm:"id"`
 	ExternalSource  ExternalSourceType
 	FullPermissions bool
-	// TODO: implement granular permissions, like this:
-	// deploymentsTheyHaveAccessTo []string
+	// what the user can do, if they don't have full permissions
+	Scopes []Scope
 }
 
 type BearerToken struct {
 	Id string `storm:"id"`
+	// not used for anything by the system, just exists for the user
+	Name string
 	// generated by bcrypt - includes built-in salt
 	TokenHash       []byte
 	FullPermissions bool
-	// TODO: implement granular permissions, like this:
-	// deploymentsTheyHaveAccessTo []string
+	// what the token can be used for, if it doesn't have full permissions
+	Scopes []Scope
+
+	CreatedAt time.Time
+	// the token stops working after this, unless it's zero
+	ExpiresAt time.Time
+	// this is only updated every so often, so it's approximate
+	LastUsedAt time.Time
+	// the token stops working if this is set. revoked tokens are kept
+	// around so that there's a record of them
+	RevokedAt time.Time
+	// when the secret part of the token was last replaced
+	RotatedAt time.Time
+}
+
+// a login to the admin dashboard. sessions don't have permissions of their own;
+// they get them from the bearer token or external user that was used to log
+// in, so that revoking the token (or unregistering the user) ends the session
+type Session struct {
+	// sha256 hash of the session's secret, which is only kept in the cookie
+	Id string `storm:"id"`
+	// either this or the external user is set, depending on how the session
+	// was started
+	BearerTokenId string
+	// when the bearer token had last been rotated as of the login. rotating
+	// the token again ends the session, since the old secret shouldn't be
+	// good for anything anymore
+	TokenRotatedAt time.Time
+	ExternalSource ExternalSourceType
+	ExternalId     string
+	// who logged in, for display
+	Identity  string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// a record of something that was done with the admin api. entries are only
+// ever added, never changed or deleted
+type AuditEntry struct {
+	Id   int       `storm:"id,increment"`
+	Time time.Time `storm:"index"`
+	// who did it, from the identity of the permissions that the request had,
+	// like "token 1a2b3c4d", "github:someone (owner/repo, run 123)", or "local"
+	Actor string
+	// the operation id of the admin api endpoint, like "DeployFiles"
+	Action string
+	// what was affected, which is a deployment's url for most actions and a
+	// token id or user for the others. empty if nothing in particular was
+	Target string
+	// the hash of the content revision that was deployed, if there was one
+	ContentHash string
+	// the http status of the response, and what that means: "success",
+	// "denied", or "failed"
+	Status  int
+	Outcome AuditOutcome
+	// where the request came from
+	RemoteAddr string
+}
+
+type AuditOutcome string
+
+const (
+	AuditSuccess AuditOutcome = "success"
+	AuditDenied  AuditOutcome = "denied"
+	AuditFailed  AuditOutcome = "failed"
+)
+
+// entries have to match all of the fields that are set
+type AuditFilter struct {
+	// matches actors that contain this
+	Actor   string
+	Action  string
+	Target  string
+	Outcome AuditOutcome
+	Since   time.Time
+	Until   time.Time
+	// the most entries to return, starting from the newest. zero means no
+	// limit
+	Limit int
+}
+
+type HeaderOperation string
+
+const (
+	SetHeader    HeaderOperation = "set"
+	AddHeader    HeaderOperation = "add"
+	DeleteHeader HeaderOperation = "delete"
+)
+
+// a change to the headers of the responses from a deployment. Value isn't used
+// for deletions. if Path is set, it's a glob like "/assets/*" that the path of
+// the request (relative to the deployment's url) has to match for the rule to
+// apply
+type HeaderRule struct {
+	Operation HeaderOperation
+	Name      string
+	Value     string
+	Path      string
+}
+
+// a rule from a _redirects file (in the same format as netlify's). From is a
+// path that can have placeholders like "/blog/:year/:slug" and can end with a
+// "*" splat; To can refer to those as ":year", ":slug", and ":splat". a Status
+// of 200 means that To is served in place of From without redirecting, and a
+// Status of 404 means the same thing except with a 404 status. if Force is
+// false, the rule doesn't apply to paths where there's an actual file
+type RedirectRule struct {
+	From   string
+	To     string
+	Status int
+	Force  bool
+}
+
+// someone who can get into a deployment with http basic auth. PasswordHash is
+// generated by bcrypt, like the hashes for bearer tokens
+type BasicAuthUser struct {
+	Username     string
+	PasswordHash string
+}
+
+// settings that keep a deployment from being public. requests from the
+// DeniedIps are always turned away; if there are any AllowedIps, requests have
+// to come from one of those; and if there are any BasicAuthUsers or there's a
+// ShareToken, requests need either a username and password or the share token.
+// the ips are single addresses or ranges in cidr notation. the share token is
+// given as the "golf_share" query parameter, which is then kept in a cookie
+type AccessControl struct {
+	BasicAuthUsers []BasicAuthUser
+	AllowedIps     []string
+	DeniedIps      []string
+	ShareToken     string
 }
 
 type DeploymentMetadata struct {
@@ -70,6 +230,32 @@ type DeploymentMetadata struct {
 	// not used for anything by the system, just exists for the user
 	Name string
 
+	// if this is set, whoever is allowed to create previews for this deployment
+	// (e.g. CI for its github repo) can create preview deployments at
+	// subdomains of this domain, like "pr-123.[PreviewDomain]". they're deleted
+	// automatically after PreviewTTL
+	PreviewDomain string
+	PreviewTTL    time.Duration
+
+	// these are set for preview deployments. PreviewOf is the url of the
+	// deployment whose PreviewDomain this deployment is under
+	PreviewOf Url
+	ExpiresAt time.Time
+
+	// names of presets for common security headers (see
+	// public.SecurityHeaderPresets) to add to responses from the deployment,
+	// and then other header rules, which are applied after the presets (so
+	// that they can override them)
+	SecurityHeaders []string
+	HeaderRules     []HeaderRule
+
+	// pages from a static deployment's files to show when it responds with an
+	// error. the keys are status codes like "404", or ranges of them like
+	// "5xx" or "50x", and the values are paths like "/404.html"
+	ErrorPages map[string]string
+
+	AccessControl AccessControl
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -82,8 +268,9 @@ type DeploymentContent struct {
 	// > 0, or build in a "NotSureYet" value for ServedThingType?)
 	HasContent bool
 	// for static files, this is the path to a local directory; for a docker
-	// container, this is a port number (?); for a redirect, this is a url or url
-	// path; for a reverse proxy, this is a host and port (probably "localhost:[port]")
+	// container or native process, this is the host and port that it can be
+	// reached at; for a redirect, this is a url or url path; for a reverse
+	// proxy, this is a host and port (probably "localhost:[port]")
 	ServedThing     string
 	ServedThingType ServedThingType
 
@@ -91,14 +278,64 @@ type DeploymentContent struct {
 	// separate type for that content:
 	SpaMode bool
 
+	// these also only make sense for static sites. they come from the
+	// _redirects and _headers files at the root of the site's files, if it
+	// has them, and SiteConfigErrors describes anything in those files that
+	// couldn't be used
+	Redirects        []RedirectRule
+	FileHeaderRules  []HeaderRule
+	SiteConfigErrors []string
+
 	// these only makes sense for aliases:
 	AliasedTo Url
 	Redirect  bool
+
+	// these only make sense for docker containers. they're needed to recreate
+	// the container when the server restarts
+	ContainerImage string
+	ContainerPort  int
+
+	// this only makes sense for native processes. it's the path of the
+	// executable on the server
+	ProcessExecutable string
+
+	// these only make sense for reverse proxies. if ProxyUpstreams is empty,
+	// ServedThing is used as the only upstream (which is how the admin api's
+	// deployment works)
+	ProxyUpstreams []string
+	// one of caddy's load balancing selection policies, like "round_robin";
+	// caddy's default ("random") is used if this is empty
+	ProxyLoadBalancing string
+	// if this is set, caddy will request this path from each upstream every
+	// ProxyHealthCheckInterval and stop sending traffic to ones that fail
+	ProxyHealthCheckPath     string
+	ProxyHealthCheckInterval string
+	// request headers that are set on requests to the upstreams, overriding
+	// the defaults
+	ProxyHeaders map[string]string
+}
+
+// a version of a deployment's content that was uploaded at some point. these
+// are kept around so that the deployment can be rolled back to them
+type ContentRevision struct {
+	// hash of the uploaded archive; also used to identify the revision
+	Hash string
+	// where the revision's files are on disk
+	Path       string
+	CreatedAt  time.Time
+	UploadedBy string
+	// size of the uploaded archive in bytes
+	Size int64
 }
 
 type Deployment struct {
 	DeploymentMetadata `storm:"inline"`
 	DeploymentContent  `storm:"inline"`
+
+	// the content that has been uploaded for this deployment over time, oldest
+	// first. this is kept separately from DeploymentContent since that gets
+	// replaced every time the content changes
+	Revisions []ContentRevision
 }
 
 type Url struct {
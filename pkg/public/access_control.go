@@ -0,0 +1,172 @@
+package public
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/caddyserver/caddy/v2/modules/caddyhttp"
+	"github.com/internet-golf/internet-golf/pkg/db"
+	"github.com/internet-golf/internet-golf/pkg/utils"
+	"golang.org/x/crypto/bcrypt"
+
+	_ "github.com/caddyserver/caddy/v2/modules/caddyhttp/caddyauth"
+)
+
+// the name of both the query parameter that a share token is given in and the
+// cookie that it's kept in afterwards
+const ShareTokenParam = "golf_share"
+
+// share tokens end up in urls and cookies, so they're limited to characters
+// that don't need escaping in either. they also have to be long enough that
+// they can't be guessed
+var shareTokenFormat = regexp.MustCompile(`^[A-Za-z0-9_-]{16,}$`)
+
+// returns an error if the access control settings couldn't be turned into
+// caddy handlers
+func ValidateAccessControl(ac db.AccessControl) error {
+	usernames := map[string]bool{}
+	for _, user := range ac.BasicAuthUsers {
+		// caddy would treat braces as placeholders, and a colon would end
+		// the username in the authorization header
+		if len(user.Username) == 0 || strings.ContainsAny(user.Username, ":{}") {
+			return fmt.Errorf("\"%s\" is not a valid username", user.Username)
+		}
+		if usernames[user.Username] {
+			return fmt.Errorf("there's more than one user named %s", user.Username)
+		}
+		usernames[user.Username] = true
+		if _, err := bcrypt.Cost([]byte(user.PasswordHash)); err != nil {
+			return fmt.Errorf("the password hash for %s is not a bcrypt hash", user.Username)
+		}
+	}
+	for _, ip := range append(append([]string{}, ac.AllowedIps...), ac.DeniedIps...) {
+		if _, err := netip.ParsePrefix(ip); err == nil {
+			continue
+		}
+		if _, err := netip.ParseAddr(ip); err != nil {
+			return fmt.Errorf("\"%s\" is not an ip address or a range of them in cidr notation", ip)
+		}
+	}
+	if len(ac.ShareToken) > 0 && !shareTokenFormat.MatchString(ac.ShareToken) {
+		return fmt.Errorf(
+			"share tokens have to be at least 16 characters long and can only have letters, numbers, _, and -",
+		)
+	}
+	return nil
+}
+
+func hasAccessControl(ac db.AccessControl) bool {
+	return len(ac.BasicAuthUsers) > 0 || len(ac.AllowedIps) > 0 || len(ac.DeniedIps) > 0 ||
+		len(ac.ShareToken) > 0
+}
+
+// puts the deployment's access control in front of the handlers of each of its
+// routes. deployments without any access control get their routes back as they
+// are
+func withAccessControl(d db.Deployment, routes []caddyhttp.Route) ([]caddyhttp.Route, error) {
+	ac := d.AccessControl
+	if !hasAccessControl(ac) {
+		return routes, nil
+	}
+	if err := ValidateAccessControl(ac); err != nil {
+		return nil, err
+	}
+
+	forbidden := []utils.JsonObj{{
+		"handler":     "static_response",
+		"status_code": 403,
+		"body":        "You don't have access to this page.",
+	}}
+
+	checks := []utils.JsonObj{}
+	if len(ac.DeniedIps) > 0 {
+		checks = append(checks, utils.JsonObj{
+			"match":  []utils.JsonObj{{"remote_ip": utils.JsonObj{"ranges": ac.DeniedIps}}},
+			"handle": forbidden,
+		})
+	}
+	if len(ac.AllowedIps) > 0 {
+		checks = append(checks, utils.JsonObj{
+			"match": []utils.JsonObj{{
+				"not": []utils.JsonObj{{"remote_ip": utils.JsonObj{"ranges": ac.AllowedIps}}},
+			}},
+			"handle": forbidden,
+		})
+	}
+
+	// requests with the share token (in the query string the first time, and
+	// in a cookie after that) are let in without a username and password.
+	// whether they've been let in is kept in a variable for the next check
+	granted := utils.JsonObj{"handler": "vars", "golf_access": "granted"}
+	if len(ac.ShareToken) > 0 {
+		cookiePath, _ := strings.CutSuffix(d.Url.Path, "*")
+		if len(cookiePath) == 0 {
+			cookiePath = "/"
+		}
+		cookie := fmt.Sprintf(
+			"%s=%s; Path=%s; HttpOnly; SameSite=Lax", ShareTokenParam, ac.ShareToken, cookiePath,
+		)
+		checks = append(checks,
+			utils.JsonObj{
+				"match": []utils.JsonObj{{"query": utils.JsonObj{ShareTokenParam: []string{ac.ShareToken}}}},
+				"handle": []utils.JsonObj{
+					granted,
+					{"handler": "headers", "response": utils.JsonObj{
+						"add": utils.JsonObj{"Set-Cookie": []string{cookie}},
+					}},
+				},
+			},
+			utils.JsonObj{
+				"match": []utils.JsonObj{{"vars": utils.JsonObj{
+					"{http.request.cookie." + ShareTokenParam + "}": []string{ac.ShareToken},
+				}}},
+				"handle": []utils.JsonObj{granted},
+			},
+		)
+	}
+
+	notGranted := []utils.JsonObj{{
+		"not": []utils.JsonObj{{"vars": utils.JsonObj{"golf_access": []string{"granted"}}}},
+	}}
+	if len(ac.BasicAuthUsers) > 0 {
+		accounts := []utils.JsonObj{}
+		for _, user := range ac.BasicAuthUsers {
+			accounts = append(accounts, utils.JsonObj{
+				"username": user.Username, "password": user.PasswordHash,
+			})
+		}
+		checks = append(checks, utils.JsonObj{
+			"match": notGranted,
+			"handle": []utils.JsonObj{{
+				"handler": "authentication",
+				"providers": utils.JsonObj{
+					"http_basic": utils.JsonObj{
+						"accounts": accounts,
+						// bcrypt is slow on purpose, so without this, every
+						// request would take a noticeable amount of time
+						"hash_cache": utils.JsonObj{},
+					},
+				},
+			}},
+		})
+	} else if len(ac.ShareToken) > 0 {
+		checks = append(checks, utils.JsonObj{"match": notGranted, "handle": forbidden})
+	}
+
+	protected := []caddyhttp.Route{}
+	for _, route := range routes {
+		protected = append(protected, caddyhttp.Route{
+			MatcherSetsRaw: route.MatcherSetsRaw,
+			Terminal:       route.Terminal,
+			HandlersRaw: []json.RawMessage{utils.JsonOrPanic(utils.JsonObj{
+				"handler": "subroute",
+				"routes":  slices.Concat(checks, []utils.JsonObj{{"handle": route.HandlersRaw}}),
+			})},
+		})
+	}
+	return protected, nil
+}
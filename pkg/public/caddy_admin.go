@@ -0,0 +1,151 @@
+package public
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gosimple/slug"
+	"github.com/internet-golf/internet-golf/pkg/db"
+)
+
+// where the routes live in caddy's config
+var routesConfigPath = "/config/apps/http/servers/" + httpAppServerName + "/routes"
+
+// every route gets an "@id" in caddy's config, which lets it be changed through
+// caddy's admin api without having to find it first. the id is based on the
+// deployment's url, so it stays the same for as long as the deployment exists.
+// the hash is there because different urls can have the same slug
+func routeId(url db.Url, index int) string {
+	hash := md5.Sum([]byte(url.String()))
+	return fmt.Sprintf(
+		"deployment-%s-%s-%d", slug.Make(url.String()), hex.EncodeToString(hash[0:4]), index,
+	)
+}
+
+// encodes v as a json object with an extra "@id" field
+func jsonWithId(v any, id string) (json.RawMessage, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, err
+	}
+	fields["@id"], _ = json.Marshal(id)
+	return json.Marshal(fields)
+}
+
+func orderedRoutes(routeIds []string, routeJson map[string]json.RawMessage) []json.RawMessage {
+	routes := []json.RawMessage{}
+	for _, id := range routeIds {
+		routes = append(routes, routeJson[id])
+	}
+	return routes
+}
+
+// brings caddy's routes in line with the new ones by only sending it the
+// routes that were removed, changed, or added, so that the other deployments
+// are left alone. (caddy does still reload its config after each change, but
+// that's graceful, and it keeps its listeners and certificates across
+// reloads.) if anything goes wrong along the way, all of the routes are
+// replaced at once instead
+func (c *CaddyServer) updateRoutes(routeIds []string, routeJson map[string]json.RawMessage) error {
+	if err := c.updateChangedRoutes(routeIds, routeJson); err != nil {
+		fmt.Printf("could not update routes individually, so replacing all of them: %v\n", err)
+		allRoutes, err := json.Marshal(orderedRoutes(routeIds, routeJson))
+		if err != nil {
+			return err
+		}
+		if err := c.adminRequest(http.MethodPatch, routesConfigPath, allRoutes); err != nil {
+			c.routeIds = nil
+			return err
+		}
+	}
+	c.routeIds = routeIds
+	c.routeJson = routeJson
+	return nil
+}
+
+func (c *CaddyServer) updateChangedRoutes(routeIds []string, routeJson map[string]json.RawMessage) error {
+	if c.routeIds == nil {
+		return fmt.Errorf("current routes are unknown")
+	}
+	current := slices.Clone(c.routeIds)
+
+	for _, id := range c.routeIds {
+		if _, stillExists := routeJson[id]; stillExists {
+			continue
+		}
+		if err := c.adminRequest(http.MethodDelete, "/id/"+id, nil); err != nil {
+			return err
+		}
+		current = slices.DeleteFunc(current, func(other string) bool { return other == id })
+	}
+
+	// new routes are inserted right where they belong, which only works if
+	// the routes that are already there are still in the same order
+	// relative to each other
+	if !isSubsequence(current, routeIds) {
+		return fmt.Errorf("routes were reordered")
+	}
+
+	for _, id := range current {
+		if !bytes.Equal(c.routeJson[id], routeJson[id]) {
+			if err := c.adminRequest(http.MethodPatch, "/id/"+id, routeJson[id]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, id := range routeIds {
+		if i < len(current) && current[i] == id {
+			continue
+		}
+		// a PUT to an index in an array inserts the value there
+		if err := c.adminRequest(
+			http.MethodPut, routesConfigPath+"/"+strconv.Itoa(i), routeJson[id],
+		); err != nil {
+			return err
+		}
+		current = slices.Insert(current, i, id)
+	}
+
+	return nil
+}
+
+// whether all of the items in a appear in b in the same order
+func isSubsequence(a []string, b []string) bool {
+	next := 0
+	for _, item := range b {
+		if next < len(a) && a[next] == item {
+			next++
+		}
+	}
+	return next == len(a)
+}
+
+func (c *CaddyServer) adminRequest(method string, path string, body []byte) error {
+	req, err := http.NewRequest(method, "http://"+c.adminAddress+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("caddy admin api returned %d for %s %s: %s", resp.StatusCode, method, path, message)
+	}
+	return nil
+}
@@ -3,7 +3,10 @@ package public
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/caddyserver/caddy/v2"
@@ -12,8 +15,13 @@ import (
 	"github.com/internet-golf/internet-golf/pkg/utils"
 )
 
+// returns a caddy route that proxies requests to the host:port in
+// d.ServedThing. this is used for plain reverse proxies and also for docker
+// containers and native processes, since those are just reverse proxies to a
+// port on localhost.
 func GetCaddyReverseProxyRoute(d db.Deployment) ([]caddyhttp.Route, error) {
-	if d.ServedThingType != db.ReverseProxy {
+	if d.ServedThingType != db.ReverseProxy && d.ServedThingType != db.DockerContainer &&
+		d.ServedThingType != db.NativeProcess {
 		return []caddyhttp.Route{}, fmt.Errorf(
 			"deployment with name %s passed to "+
 				"getCaddyReverseProxyRoute despite having resource type %s",
@@ -21,29 +29,60 @@ func GetCaddyReverseProxyRoute(d db.Deployment) ([]caddyhttp.Route, error) {
 		)
 	}
 
+	requestHeaders := utils.JsonObj{}
+	for name, value := range d.ProxyHeaders {
+		requestHeaders[name] = []string{value}
+	}
+	// these come last so that nothing in ProxyHeaders can replace them
+	requestHeaders["Host"] = []string{"{http.request.host}"}
+	requestHeaders["X-Forwarded-For"] = []string{"{http.request.remote}"}
+
+	upstreamAddresses := d.ProxyUpstreams
+	if len(upstreamAddresses) == 0 {
+		upstreamAddresses = []string{d.ServedThing}
+	}
+	upstreams := []utils.JsonObj{}
+	for _, address := range upstreamAddresses {
+		upstreams = append(upstreams, utils.JsonObj{"dial": address})
+	}
+
+	reverseProxy := utils.JsonObj{
+		"handler": "reverse_proxy",
+		"headers": utils.JsonObj{
+			"request": utils.JsonObj{"set": requestHeaders},
+		},
+		"upstreams": upstreams,
+	}
+	if len(d.ProxyLoadBalancing) > 0 {
+		reverseProxy["load_balancing"] = utils.JsonObj{
+			"selection_policy": utils.JsonObj{"policy": d.ProxyLoadBalancing},
+		}
+	}
+	if len(d.ProxyHealthCheckPath) > 0 {
+		activeHealthCheck := utils.JsonObj{"uri": d.ProxyHealthCheckPath}
+		if len(d.ProxyHealthCheckInterval) > 0 {
+			activeHealthCheck["interval"] = d.ProxyHealthCheckInterval
+		}
+		reverseProxy["health_checks"] = utils.JsonObj{"active": activeHealthCheck}
+	}
+
+	headerSubroutes, err := getHeaderSubroutes(d)
+	if err != nil {
+		return []caddyhttp.Route{}, err
+	}
+
 	handlers := []json.RawMessage{
 		utils.JsonOrPanic(utils.JsonObj{
 			"handler": "subroute",
-			"routes": []utils.JsonObj{
-				{
-					"handle": []utils.JsonObj{
-						{"handler": "rewrite", "strip_path_prefix": d.Url.Path},
-						{
-							"handler": "reverse_proxy",
-							// TODO: someday, control this with a setting? maybe?
-							"headers": utils.JsonObj{
-								"request": utils.JsonObj{
-									"set": utils.JsonObj{
-										"Host":            []string{"{http.request.host}"},
-										"X-Forwarded-For": []string{"{http.request.remote}"},
-									},
-								},
-							},
-							"upstreams": []utils.JsonObj{{"dial": d.ServedThing}},
-						},
-					},
+			"routes": slices.Concat(
+				[]utils.JsonObj{
+					{"handle": []utils.JsonObj{{"handler": "rewrite", "strip_path_prefix": d.Url.Path}}},
 				},
-			},
+				headerSubroutes,
+				[]utils.JsonObj{
+					{"handle": []utils.JsonObj{reverseProxy}},
+				},
+			),
 		}),
 	}
 
@@ -100,6 +139,20 @@ func GetCaddyStaticRoutes(d db.Deployment) ([]caddyhttp.Route, error) {
 		)
 	}
 
+	// the header rules come after the path prefix is stripped, since their
+	// paths are relative to the deployment's url
+	headerSubroutes, err := getHeaderSubroutes(d)
+	if err != nil {
+		return nil, err
+	}
+	initialSubroutes = append(initialSubroutes, headerSubroutes...)
+
+	locationPrefix := ""
+	if !d.DeploymentMetadata.PreserveExternalPath {
+		locationPrefix = strings.TrimSuffix(cleanPath, "/")
+	}
+	initialSubroutes = append(initialSubroutes, getRedirectSubroutes(d, locationPrefix)...)
+
 	if d.DeploymentContent.SpaMode {
 		initialSubroutes = append(initialSubroutes,
 			utils.JsonObj{
@@ -134,16 +187,23 @@ func GetCaddyStaticRoutes(d db.Deployment) ([]caddyhttp.Route, error) {
 		},
 	}
 
-	handlers := []json.RawMessage{
-		utils.JsonOrPanic(utils.JsonObj{
-			"handler": "subroute",
-			"routes":  slices.Concat(initialSubroutes, []utils.JsonObj{finalSubroute}),
-		}),
+	subroute := utils.JsonObj{
+		"handler": "subroute",
+		"routes":  slices.Concat(initialSubroutes, []utils.JsonObj{finalSubroute}),
+	}
+	// the headers are set again for error pages, since errors are handled
+	// outside of the handlers that would have set them
+	errorRoutes, err := getErrorRoutes(d, headerSubroutes)
+	if err != nil {
+		return nil, err
+	}
+	if len(errorRoutes) > 0 {
+		subroute["errors"] = utils.JsonObj{"routes": errorRoutes}
 	}
 
 	routes = append(routes, caddyhttp.Route{
 		MatcherSetsRaw: caddyhttp.RawMatcherSets{matcher},
-		HandlersRaw:    handlers,
+		HandlersRaw:    []json.RawMessage{utils.JsonOrPanic(subroute)},
 	})
 
 	return routes, nil
@@ -195,6 +255,109 @@ func GetCaddyRedirectRoute(d db.Deployment) ([]caddyhttp.Route, error) {
 	}}, nil
 }
 
+// presets for common security headers that deployments can turn on by name.
+// these are reasonably strict, so a deployment that needs something looser
+// (like a content security policy that allows a cdn) can override them with
+// its own header rules
+var SecurityHeaderPresets = map[string]db.HeaderRule{
+	"hsts": {
+		Operation: db.SetHeader,
+		Name:      "Strict-Transport-Security",
+		Value:     "max-age=31536000; includeSubDomains",
+	},
+	"csp": {
+		Operation: db.SetHeader,
+		Name:      "Content-Security-Policy",
+		Value:     "default-src 'self'; frame-ancestors 'self'; base-uri 'self'; form-action 'self'",
+	},
+	"frame-options": {
+		Operation: db.SetHeader,
+		Name:      "X-Frame-Options",
+		Value:     "SAMEORIGIN",
+	},
+	"content-type-options": {
+		Operation: db.SetHeader,
+		Name:      "X-Content-Type-Options",
+		Value:     "nosniff",
+	},
+	"referrer-policy": {
+		Operation: db.SetHeader,
+		Name:      "Referrer-Policy",
+		Value:     "strict-origin-when-cross-origin",
+	},
+}
+
+// returns an error if the header rule couldn't be turned into a caddy handler
+func ValidateHeaderRule(rule db.HeaderRule) error {
+	if len(rule.Name) == 0 || strings.ContainsAny(rule.Name, " :\r\n") {
+		return fmt.Errorf("\"%s\" is not a valid header name", rule.Name)
+	}
+	if strings.ContainsAny(rule.Value, "\r\n") {
+		return fmt.Errorf("the value for header %s can't contain line breaks", rule.Name)
+	}
+	// caddy fills in placeholders in all of these, and some placeholders (like
+	// {env.*} and {file.*}) would give away things on the server
+	if strings.ContainsAny(rule.Name+rule.Value+rule.Path, "{}") {
+		return fmt.Errorf("header rule for %s can't contain { or }", rule.Name)
+	}
+	if len(rule.Path) > 0 && !strings.HasPrefix(rule.Path, "/") && !strings.HasPrefix(rule.Path, "*") {
+		return fmt.Errorf("header rule path \"%s\" has to start with / or *", rule.Path)
+	}
+	switch rule.Operation {
+	case db.SetHeader, db.AddHeader, db.DeleteHeader:
+		return nil
+	default:
+		return fmt.Errorf("\"%s\" is not a header operation", rule.Operation)
+	}
+}
+
+// returns a subroute with a caddy "headers" handler for each of the
+// deployment's security header presets and header rules. the handlers are
+// deferred, so that they apply to the headers that the file server or reverse
+// proxy ends up sending instead of being overwritten by them
+func getHeaderSubroutes(d db.Deployment) ([]utils.JsonObj, error) {
+	rules := []db.HeaderRule{}
+	for _, preset := range d.SecurityHeaders {
+		rule, exists := SecurityHeaderPresets[preset]
+		if !exists {
+			return nil, fmt.Errorf("\"%s\" is not a security header preset", preset)
+		}
+		rules = append(rules, rule)
+	}
+	// the rules from the deployment's own metadata come after the ones from
+	// its files, so that whoever manages the deployment gets the last word
+	rules = append(rules, d.FileHeaderRules...)
+	rules = append(rules, d.HeaderRules...)
+
+	subroutes := []utils.JsonObj{}
+	for _, rule := range rules {
+		if err := ValidateHeaderRule(rule); err != nil {
+			return nil, err
+		}
+		response := utils.JsonObj{"deferred": true}
+		switch rule.Operation {
+		case db.SetHeader:
+			response["set"] = utils.JsonObj{rule.Name: []string{rule.Value}}
+		case db.AddHeader:
+			response["add"] = utils.JsonObj{rule.Name: []string{rule.Value}}
+		case db.DeleteHeader:
+			response["delete"] = []string{rule.Name}
+		}
+		subroute := utils.JsonObj{
+			"handle": []utils.JsonObj{{"handler": "headers", "response": response}},
+		}
+		if len(rule.Path) > 0 {
+			subroute["match"] = []utils.JsonObj{{"path": []string{rule.Path}}}
+		}
+		subroutes = append(subroutes, subroute)
+	}
+	// each handler wraps the response writer of the ones before it, so the
+	// deferred operations of the handler that runs first are applied last.
+	// the rules are reversed so that later ones win
+	slices.Reverse(subroutes)
+	return subroutes, nil
+}
+
 // TODO: remove requireDomain argument. if enforced, that should be validated at
 // the api call/deployment creation level
 // utility function used by route creator functions above
@@ -218,3 +381,63 @@ func urlToMatcher(url db.Url, requireDomain bool, makePathCatchAll bool) (caddy.
 
 	return matcher, nil
 }
+
+// error page statuses are status codes like "404", or ranges of them like
+// "5xx" (500-599) or "50x" (500-509)
+var errorPageStatus = regexp.MustCompile(`^[45](\d\d|\dx|xx)$`)
+
+// returns an error if the error page couldn't be turned into a caddy route
+func ValidateErrorPage(status string, page string) error {
+	if !errorPageStatus.MatchString(status) {
+		return fmt.Errorf(
+			"\"%s\" is not an error status code or a range of them like \"5xx\" or \"50x\"", status,
+		)
+	}
+	if !strings.HasPrefix(page, "/") || strings.ContainsAny(page, "{}") {
+		return fmt.Errorf("error page \"%s\" has to be a path starting with /", page)
+	}
+	return nil
+}
+
+// returns caddy's error handling routes for the deployment's error pages, or
+// nil if it doesn't have any. these run (with the request as it was when the
+// error happened) when a handler in the deployment's subroute returns an
+// error, like when the file server can't find a file
+func getErrorRoutes(d db.Deployment, headerSubroutes []utils.JsonObj) ([]utils.JsonObj, error) {
+	if len(d.ErrorPages) == 0 {
+		return nil, nil
+	}
+
+	// the most specific statuses (the ones with the fewest x's) go first, so
+	// that "404" wins over "4xx"
+	statuses := slices.Collect(maps.Keys(d.ErrorPages))
+	slices.SortFunc(statuses, func(a string, b string) int {
+		if byXs := strings.Count(a, "x") - strings.Count(b, "x"); byXs != 0 {
+			return byXs
+		}
+		return strings.Compare(a, b)
+	})
+
+	routes := slices.Clone(headerSubroutes)
+	for _, status := range statuses {
+		page := d.ErrorPages[status]
+		if err := ValidateErrorPage(status, page); err != nil {
+			return nil, err
+		}
+		lowest, _ := strconv.Atoi(strings.ReplaceAll(status, "x", "0"))
+		highest, _ := strconv.Atoi(strings.ReplaceAll(status, "x", "9"))
+		routes = append(routes, utils.JsonObj{
+			"group": "error-pages",
+			"match": []utils.JsonObj{{
+				"expression": fmt.Sprintf(
+					"{http.error.status_code} >= %d && {http.error.status_code} <= %d", lowest, highest,
+				),
+			}},
+			"handle": []utils.JsonObj{
+				{"handler": "rewrite", "uri": page},
+				{"handler": "file_server", "status_code": "{http.error.status_code}"},
+			},
+		})
+	}
+	return routes, nil
+}
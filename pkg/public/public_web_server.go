@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strconv"
 
@@ -40,15 +41,57 @@ type CaddyServer struct {
 	config      *utils.Config
 	dataPath    string
 	onDemandTls onDemandTls
+	// where caddy's admin api listens. this is picked once, at a random port
+	// that is only known within this program, which might make it slightly
+	// harder to reach and exploit 🤞
+	adminAddress string
+	// whether caddy has been started yet. after it's started, it's only
+	// updated through its admin api
+	running bool
+	// the ids of the routes that caddy is currently serving, in order, and the
+	// json for each one. if this is nil while caddy is running, it's not
+	// known what caddy is serving, so all of the routes get replaced
+	routeIds  []string
+	routeJson map[string]json.RawMessage
 }
 
 const httpAppServerName = "internetgolf"
 
 func NewPublicWebServer(config *utils.Config, files *resources.FileManager) (PublicWebServer, error) {
-	return &CaddyServer{config: config, dataPath: files.CaddyDataPath}, nil
+	adminPort, err := utils.GetFreePort()
+	if err != nil {
+		return nil, err
+	}
+	return &CaddyServer{
+		config:       config,
+		dataPath:     files.CaddyDataPath,
+		adminAddress: "localhost:" + strconv.Itoa(adminPort),
+	}, nil
 }
 
+// returns the routes for the deployment, behind its access control (if it
+// has any)
 func getCaddyRoute(deployment db.Deployment, allDeployments []db.Deployment) ([]caddyhttp.Route, error) {
+	routes, err := getUnprotectedCaddyRoute(deployment, allDeployments)
+	if err != nil {
+		return nil, err
+	}
+	// an alias that serves another deployment's content without any access
+	// control of its own gets the other deployment's, so that it can't be used
+	// to get around it
+	if deployment.ServedThingType == db.Alias && !deployment.Redirect &&
+		!hasAccessControl(deployment.AccessControl) {
+		aliasedToIndex := slices.IndexFunc(allDeployments, func(e db.Deployment) bool {
+			return deployment.AliasedTo.Equals(&e.Url)
+		})
+		if aliasedToIndex != -1 {
+			deployment.AccessControl = allDeployments[aliasedToIndex].AccessControl
+		}
+	}
+	return withAccessControl(deployment, routes)
+}
+
+func getUnprotectedCaddyRoute(deployment db.Deployment, allDeployments []db.Deployment) ([]caddyhttp.Route, error) {
 	type deploymentToCaddyRouteConverter = func(d db.Deployment) ([]caddyhttp.Route, error)
 	var internalGetCaddyRoute deploymentToCaddyRouteConverter
 
@@ -71,7 +114,7 @@ func getCaddyRoute(deployment db.Deployment, allDeployments []db.Deployment) ([]
 		switch deployment.ServedThingType {
 		case db.StaticFiles:
 			internalGetCaddyRoute = GetCaddyStaticRoutes
-		case db.ReverseProxy:
+		case db.ReverseProxy, db.DockerContainer, db.NativeProcess:
 			internalGetCaddyRoute = GetCaddyReverseProxyRoute
 		case db.Alias:
 			internalGetCaddyRoute = func(d db.Deployment) ([]caddyhttp.Route, error) {
@@ -89,8 +132,10 @@ func getCaddyRoute(deployment db.Deployment, allDeployments []db.Deployment) ([]
 						}, "aliases to aliases are not allowed",
 					)
 				}
-				// this is maybe a little bit too clever
-				return getCaddyRoute(db.Deployment{
+				// this is maybe a little bit too clever. (the alias's own
+				// access control is added by getCaddyRoute, not the aliased
+				// deployment's)
+				return getUnprotectedCaddyRoute(db.Deployment{
 					DeploymentMetadata: d.DeploymentMetadata,
 					DeploymentContent:  aliasedToDeployment.DeploymentContent,
 				}, allDeployments)
@@ -104,6 +149,37 @@ func getCaddyRoute(deployment db.Deployment, allDeployments []db.Deployment) ([]
 	return internalGetCaddyRoute(deployment)
 }
 
+// the route for requests that don't match any deployment. this shows the page
+// at defaultPage if there is one, and a status message otherwise
+func notFoundRoute(defaultPage string) caddyhttp.Route {
+	if len(defaultPage) > 0 {
+		if absolutePath, err := filepath.Abs(defaultPage); err == nil {
+			return caddyhttp.Route{
+				HandlersRaw: []json.RawMessage{
+					utils.JsonOrPanic(utils.JsonObj{
+						"handler": "rewrite", "uri": "/" + filepath.Base(absolutePath),
+					}),
+					utils.JsonOrPanic(utils.JsonObj{
+						"handler":     "file_server",
+						"root":        filepath.Dir(absolutePath),
+						"status_code": 404,
+					}),
+				},
+			}
+		}
+	}
+	return caddyhttp.Route{
+		HandlersRaw: []json.RawMessage{
+			utils.JsonOrPanic(utils.JsonObj{
+				"handler":     "static_response",
+				"status_code": 404,
+				"body": ("Hello! This domain is configured to point to an Internet Golf server. " +
+					"However, there is currently no active deployment or page for this URL available."),
+			}),
+		},
+	}
+}
+
 // caddy requires a huge JSON configuration object to be conveyed to it, which
 // is not the easiest thing to formulate and work with. if there's a way to
 // configure it with normal go structs, i haven't found it - it clearly uses
@@ -114,47 +190,56 @@ func getCaddyRoute(deployment db.Deployment, allDeployments []db.Deployment) ([]
 // http://localhost:[port]/config in your browser to access its admin api
 
 // puts all the deployments on the public internet. prioritizes more specific
-// urls over less specific urls
+// urls over less specific urls. the first time this is called, caddy is
+// started; after that, only the routes that changed are sent to it
 func (c *CaddyServer) DeployAll(deployments []db.Deployment) error {
-	var listen []string
-	if c.config.LocalOnly {
-		listen = []string{"localhost:80"}
-	} else {
-		listen = []string{":80", ":443"}
+	routeIds, routeJson, err := getAllRoutes(deployments, c.config.DefaultPage)
+	if err != nil {
+		return err
 	}
-	httpApp := caddyhttp.App{
-		Servers: map[string]*caddyhttp.Server{
-			httpAppServerName: {
-				Listen: listen,
-				AutoHTTPS: &caddyhttp.AutoHTTPSConfig{
-					Disabled: c.config.LocalOnly,
-				},
-				Routes: caddyhttp.RouteList{{
-					// this matches everything (apparently)
-					MatcherSetsRaw: caddyhttp.RawMatcherSets{},
-					HandlersRaw: []json.RawMessage{
-						utils.JsonOrPanic(utils.JsonObj{
-							"handler": "headers",
-							"response": map[string]any{
-								"add": map[string][]string{
-									"X-Deployed-By": []string{"Internet-Golf"},
-								},
-							},
-						}),
+	if !c.running {
+		return c.start(routeIds, routeJson)
+	}
+	return c.updateRoutes(routeIds, routeJson)
+}
+
+// a caddy route along with the "@id" that it has in caddy's config
+type identifiedRoute struct {
+	id    string
+	route caddyhttp.Route
+}
+
+// returns the ids of the routes for all of the deployments, in the order in
+// which they should be matched, and the json for each route. defaultPage is
+// the (optional) path to the page for requests that no deployment matches
+func getAllRoutes(
+	deployments []db.Deployment, defaultPage string,
+) ([]string, map[string]json.RawMessage, error) {
+	routes := []identifiedRoute{{
+		id: "golf-headers",
+		route: caddyhttp.Route{
+			// this matches everything (apparently)
+			MatcherSetsRaw: caddyhttp.RawMatcherSets{},
+			HandlersRaw: []json.RawMessage{
+				utils.JsonOrPanic(utils.JsonObj{
+					"handler": "headers",
+					"response": map[string]any{
+						"add": map[string][]string{
+							"X-Deployed-By": []string{"Internet-Golf"},
+						},
 					},
-				}},
+				}),
 			},
 		},
-	}
+	}}
 
 	for _, deployment := range deployments {
-		if routes, err := getCaddyRoute(deployment, deployments); err != nil {
+		if deploymentRoutes, err := getCaddyRoute(deployment, deployments); err != nil {
 			fmt.Printf("encountered error: %v", err)
 		} else {
-			httpApp.Servers[httpAppServerName].Routes = append(
-				httpApp.Servers[httpAppServerName].Routes,
-				routes...,
-			)
+			for i, route := range deploymentRoutes {
+				routes = append(routes, identifiedRoute{id: routeId(deployment.Url, i), route: route})
+			}
 		}
 	}
 
@@ -162,84 +247,95 @@ func (c *CaddyServer) DeployAll(deployments []db.Deployment) error {
 	// get matched with higher precedence than the less specific routes; i.e.
 	// mitch.website/thing needs to be sorted before mitch.website or else
 	// mitch.website will always be matched and mitch.website/thing will never
-	// be matched
+	// be matched. the sort is stable so that routes that are equally specific
+	// stay in the same order, which keeps them from being needlessly moved
+	// around in caddy's config
 	// TODO: test, with asterisks. need config to get admin api route from (#32)
-	slices.SortFunc(
-		httpApp.Servers[httpAppServerName].Routes,
-		func(a caddyhttp.Route, b caddyhttp.Route) int {
-			// catch-all "middleware"
-			if len(a.MatcherSetsRaw) == 0 {
-				return -1
-			}
-			if len(b.MatcherSetsRaw) == 0 {
-				return 1
-			}
-			// TODO: make sure admin API route is always first, somehow.
-			// terrible hack:
-			if string(a.MatcherSetsRaw[0]["path"]) == "/_golf*" {
-				return -1
-			} else if string(b.MatcherSetsRaw[0]["path"]) == "/_golf*" {
-				return 1
-			}
+	slices.SortStableFunc(routes, func(x identifiedRoute, y identifiedRoute) int {
+		a, b := x.route, y.route
+		// catch-all "middleware"
+		if len(a.MatcherSetsRaw) == 0 {
+			return -1
+		}
+		if len(b.MatcherSetsRaw) == 0 {
+			return 1
+		}
+		// TODO: make sure admin API route is always first, somehow.
+		// terrible hack:
+		if string(a.MatcherSetsRaw[0]["path"]) == "/_golf*" {
+			return -1
+		} else if string(b.MatcherSetsRaw[0]["path"]) == "/_golf*" {
+			return 1
+		}
 
-			// these routes are guaranteed to have only one matcher set because of
-			// how urlsToRoutes works
-			if len(a.MatcherSetsRaw[0]["path"]) == 0 && len(b.MatcherSetsRaw[0]["path"]) == 0 {
-				// if they both just have a host and no path, then they're equal
-				return 0
-			} else if len(b.MatcherSetsRaw[0]["path"]) == 0 {
-				// if only a has a path, then a is more specific and should be first
-				return -1
-			} else if len(a.MatcherSetsRaw[0]["path"]) == 0 {
-				// if only b has a path, then b is more specific and should be first
-				return 1
-			} else {
-				// otherwise, assume the longer path is more specific. which i think
-				// will give good results?
-				// TODO: account for asterisks? needs testing
-				return len(b.MatcherSetsRaw[0]["path"]) - len(a.MatcherSetsRaw[0]["path"])
-			}
-		},
-	)
+		// these routes are guaranteed to have only one matcher set because of
+		// how urlsToRoutes works
+		if len(a.MatcherSetsRaw[0]["path"]) == 0 && len(b.MatcherSetsRaw[0]["path"]) == 0 {
+			// if they both just have a host and no path, then they're equal
+			return 0
+		} else if len(b.MatcherSetsRaw[0]["path"]) == 0 {
+			// if only a has a path, then a is more specific and should be first
+			return -1
+		} else if len(a.MatcherSetsRaw[0]["path"]) == 0 {
+			// if only b has a path, then b is more specific and should be first
+			return 1
+		} else {
+			// otherwise, assume the longer path is more specific. which i think
+			// will give good results?
+			// TODO: account for asterisks? needs testing
+			return len(b.MatcherSetsRaw[0]["path"]) - len(a.MatcherSetsRaw[0]["path"])
+		}
+	})
 
 	// put a catch-all status message at the end.
-	httpApp.Servers[httpAppServerName].Routes = append(
-		httpApp.Servers[httpAppServerName].Routes,
-		caddyhttp.Route{
-			HandlersRaw: []json.RawMessage{
-				utils.JsonOrPanic(utils.JsonObj{
-					"handler":     "static_response",
-					"status_code": 404,
-					"body": ("Hello! This domain is configured to point to an Internet Golf server. " +
-						"However, there is currently no active deployment or page for this URL available."),
-				}),
-			},
-		},
-	)
+	routes = append(routes, identifiedRoute{id: "golf-not-found", route: notFoundRoute(defaultPage)})
 
-	httpJson, err := json.Marshal(httpApp)
-	if err != nil {
-		panic(err)
+	routeIds := []string{}
+	routeJson := map[string]json.RawMessage{}
+	for _, r := range routes {
+		if _, exists := routeJson[r.id]; exists {
+			return nil, nil, fmt.Errorf("more than one route has the id %s", r.id)
+		}
+		encoded, err := jsonWithId(r.route, r.id)
+		if err != nil {
+			return nil, nil, err
+		}
+		routeIds = append(routeIds, r.id)
+		routeJson[r.id] = encoded
 	}
+	return routeIds, routeJson, nil
+}
 
-	// TODO: like the auto tls server setup, the admin api port stuff is called
-	// every time DeployAll is called, when it should be more like an initial
-	// setup thing
+// starts caddy with the given routes, along with the server that approves
+// domains for on-demand tls
+func (c *CaddyServer) start(routeIds []string, routeJson map[string]json.RawMessage) error {
+	var listen []string
+	if c.config.LocalOnly {
+		listen = []string{"localhost:80"}
+	} else {
+		listen = []string{":80", ":443"}
+	}
+	httpJson := utils.JsonOrPanic(utils.JsonObj{
+		"servers": utils.JsonObj{
+			httpAppServerName: utils.JsonObj{
+				"listen":          listen,
+				"automatic_https": utils.JsonObj{"disable": c.config.LocalOnly},
+				"routes":          orderedRoutes(routeIds, routeJson),
+			},
+		},
+	})
 
-	// starting the caddy admin api at a random port that is only known within
-	// this program might make it slightly harder to reach and exploit 🤞
-	caddyAdminApiPort, _ := utils.GetFreePort()
 	logLevel := "ERROR"
 	if c.config.Verbose {
 		logLevel = "DEBUG"
 	}
 
-	fmt.Printf("Caddy API running at localhost:%v\n", caddyAdminApiPort)
+	fmt.Printf("Caddy API running at %v\n", c.adminAddress)
 
 	caddyConfig := caddy.Config{
 		AppsRaw: caddy.ModuleMap{"http": httpJson},
 		Admin: &caddy.AdminConfig{
-			Listen: "localhost:" + strconv.Itoa(caddyAdminApiPort),
+			Listen: c.adminAddress,
 		},
 		StorageRaw: utils.JsonOrPanic(map[string]string{
 			"module": "file_system",
@@ -254,28 +350,32 @@ func (c *CaddyServer) DeployAll(deployments []db.Deployment) error {
 		},
 	}
 
-	// TODO: wait, how is the tlsApprovalServer set up every time that DeployAll
-	// is called? shouldn't this be in NewPublicWebServer?
 	// if !c.config.LocalOnly {
 	tlsConfig, err := getOnDemandTls()
 	if err != nil {
-		panic(err)
+		return err
 	}
 	c.onDemandTls = tlsConfig
 	caddyConfig.AppsRaw["tls"] = utils.JsonOrPanic(tlsConfig.caddyTlsConfig)
 	go c.onDemandTls.tlsApprovalServer.ListenAndServe()
 	// }
 
-	err = caddy.Run(&caddyConfig)
-	if err != nil {
-		panic(err)
+	if err := caddy.Run(&caddyConfig); err != nil {
+		c.onDemandTls.tlsApprovalServer.Shutdown(context.TODO())
+		return err
 	}
 
+	c.running = true
+	c.routeIds = routeIds
+	c.routeJson = routeJson
 	return nil
 }
 
 func (c *CaddyServer) Stop() error {
-	if !c.config.LocalOnly {
+	c.running = false
+	c.routeIds = nil
+	c.routeJson = nil
+	if c.onDemandTls.tlsApprovalServer != nil {
 		err := c.onDemandTls.tlsApprovalServer.Shutdown(context.TODO())
 		if err != nil {
 			caddy.Stop()
@@ -0,0 +1,303 @@
+package public
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/internet-golf/internet-golf/pkg/db"
+	"github.com/internet-golf/internet-golf/pkg/utils"
+)
+
+// static sites can configure redirects and headers for themselves with
+// _redirects and _headers files, in the same formats that netlify uses, since
+// that's what a lot of front-end build tools already know how to produce:
+// https://docs.netlify.com/routing/redirects/ and
+// https://docs.netlify.com/routing/headers/. the less common features (like
+// conditions on countries or query parameters, and proxying to other sites)
+// aren't supported
+
+const redirectsFileName = "_redirects"
+const headersFileName = "_headers"
+
+// placeholder names can't start with a number, so that ports in urls aren't
+// mistaken for them
+var redirectPlaceholder = regexp.MustCompile(`:([A-Za-z_][A-Za-z0-9_]*)`)
+
+// reads the _redirects and _headers files at the root of the site in dir, if
+// they're there. lines that can't be used are skipped and described in the
+// returned errors, so that one typo doesn't stop the whole site from deploying
+func ParseSiteConfigFiles(dir string) ([]db.RedirectRule, []db.HeaderRule, []string) {
+	errors := []string{}
+
+	var redirects []db.RedirectRule
+	if contents, err := os.ReadFile(path.Join(dir, redirectsFileName)); err == nil {
+		var redirectErrors []string
+		redirects, redirectErrors = parseRedirectsFile(string(contents))
+		errors = append(errors, redirectErrors...)
+	}
+
+	var headers []db.HeaderRule
+	if contents, err := os.ReadFile(path.Join(dir, headersFileName)); err == nil {
+		var headerErrors []string
+		headers, headerErrors = parseHeadersFile(string(contents))
+		errors = append(errors, headerErrors...)
+	}
+
+	return redirects, headers, errors
+}
+
+// each line has the format "[from] [to] [status][!]", where the status
+// defaults to 301 and the "!" makes the rule apply even where there's a file
+func parseRedirectsFile(contents string) ([]db.RedirectRule, []string) {
+	rules := []db.RedirectRule{}
+	errors := []string{}
+	for i, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule, err := parseRedirectLine(line)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s line %d: %s", redirectsFileName, i+1, err.Error()))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules, errors
+}
+
+func parseRedirectLine(line string) (db.RedirectRule, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return db.RedirectRule{}, fmt.Errorf("expected a path and a destination")
+	}
+	rule := db.RedirectRule{From: fields[0], To: fields[1], Status: 301}
+
+	if !strings.HasPrefix(rule.From, "/") {
+		return db.RedirectRule{}, fmt.Errorf("%s has to be a path starting with /", rule.From)
+	}
+	// caddy fills in placeholders in redirect destinations, and some of them
+	// (like {env.*} and {file.*}) would give away things on the server
+	if strings.ContainsAny(rule.From+rule.To, "{}") {
+		return db.RedirectRule{}, fmt.Errorf("paths and destinations can't contain { or }")
+	}
+	if strings.Contains(rule.To, "=") {
+		return db.RedirectRule{}, fmt.Errorf("matching query parameters is not supported")
+	}
+	if len(fields) > 3 {
+		return db.RedirectRule{}, fmt.Errorf("conditions are not supported")
+	}
+	if len(fields) == 3 {
+		statusField, force := strings.CutSuffix(fields[2], "!")
+		status, err := strconv.Atoi(statusField)
+		if err != nil {
+			return db.RedirectRule{}, fmt.Errorf("%s is not a status code", fields[2])
+		}
+		rule.Status = status
+		rule.Force = force
+	}
+
+	switch rule.Status {
+	case 200, 404:
+		if !strings.HasPrefix(rule.To, "/") {
+			return db.RedirectRule{}, fmt.Errorf(
+				"rules with status %d can only point to paths on the same site", rule.Status,
+			)
+		}
+	case 301, 302, 303, 307, 308:
+		if !strings.HasPrefix(rule.To, "/") && !strings.HasPrefix(rule.To, "http://") &&
+			!strings.HasPrefix(rule.To, "https://") {
+			return db.RedirectRule{}, fmt.Errorf("%s is not a path or a URL", rule.To)
+		}
+	default:
+		return db.RedirectRule{}, fmt.Errorf("status %d is not supported", rule.Status)
+	}
+
+	_, placeholders, err := redirectPattern(rule.From)
+	if err != nil {
+		return db.RedirectRule{}, err
+	}
+	// the placeholders in the destination have to come from somewhere
+	for _, match := range redirectPlaceholder.FindAllStringSubmatch(rule.To, -1) {
+		if !slices.Contains(placeholders, match[1]) {
+			return db.RedirectRule{}, fmt.Errorf("%s does not have a :%s in it", rule.From, match[1])
+		}
+	}
+
+	return rule, nil
+}
+
+// a block starts with an unindented path, and the indented "Name: value" lines
+// after it are the headers for that path
+func parseHeadersFile(contents string) ([]db.HeaderRule, []string) {
+	rules := []db.HeaderRule{}
+	errors := []string{}
+	currentPath := ""
+	blockStart := 0
+	for i, rawLine := range strings.Split(contents, "\n") {
+		line := strings.TrimSpace(rawLine)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lineError := func(message string) {
+			errors = append(errors, fmt.Sprintf("%s line %d: %s", headersFileName, i+1, message))
+		}
+
+		if !strings.HasPrefix(rawLine, " ") && !strings.HasPrefix(rawLine, "\t") {
+			if !strings.HasPrefix(line, "/") {
+				lineError(fmt.Sprintf("%s has to be a path starting with /", line))
+				currentPath = ""
+				continue
+			}
+			currentPath = line
+			blockStart = len(rules)
+			continue
+		}
+
+		if len(currentPath) == 0 {
+			lineError("expected a path before the headers for it")
+			continue
+		}
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			lineError("expected a header in the format \"Name: value\"")
+			continue
+		}
+		rule := db.HeaderRule{
+			Operation: db.SetHeader,
+			Name:      strings.TrimSpace(name),
+			Value:     strings.TrimSpace(value),
+			Path:      headerPathGlob(currentPath),
+		}
+		if err := ValidateHeaderRule(rule); err != nil {
+			lineError(err.Error())
+			continue
+		}
+
+		// a header that's listed more than once for the same path gets all of
+		// the values, separated by commas
+		existing := slices.IndexFunc(rules[blockStart:], func(r db.HeaderRule) bool {
+			return strings.EqualFold(r.Name, rule.Name)
+		})
+		if existing != -1 {
+			rules[blockStart+existing].Value += ", " + rule.Value
+		} else {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, errors
+}
+
+// caddy's path matcher doesn't know about placeholders, but a placeholder
+// matches a single path segment, which is what a "*" in the middle of a glob
+// does
+func headerPathGlob(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "*"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// turns the "from" path of a redirect rule into a regular expression, and also
+// returns the names of its placeholders in the order of their capture groups
+// (with "splat" for the splat). like netlify, trailing slashes don't matter
+func redirectPattern(from string) (string, []string, error) {
+	placeholders := []string{}
+	pattern := "^"
+	segments := strings.Split(strings.TrimPrefix(from, "/"), "/")
+	for i, segment := range segments {
+		isLast := i == len(segments)-1
+		switch {
+		case segment == "*" && isLast:
+			placeholders = append(placeholders, "splat")
+			pattern += "(?:/(.*))?"
+		case segment == "*":
+			return "", nil, fmt.Errorf("%s can only have a * at the end", from)
+		case strings.HasPrefix(segment, ":") && len(segment) > 1:
+			placeholders = append(placeholders, segment[1:])
+			pattern += "/([^/]+)"
+		case len(segment) == 0 && isLast:
+			// trailing slash
+		default:
+			pattern += "/" + regexp.QuoteMeta(segment)
+		}
+	}
+	if !strings.HasSuffix(from, "*") {
+		pattern += "/?"
+	}
+	return pattern + "$", placeholders, nil
+}
+
+// returns the subroutes for the deployment's redirect rules. locationPrefix is
+// put in front of the paths that visitors are redirected to, since the rules'
+// paths are relative to the deployment's url
+func getRedirectSubroutes(d db.Deployment, locationPrefix string) []utils.JsonObj {
+	subroutes := []utils.JsonObj{
+		// netlify doesn't serve these files, so neither does this
+		{
+			"match": []utils.JsonObj{
+				{"path": []string{"/" + redirectsFileName, "/" + headersFileName}},
+			},
+			"handle": []utils.JsonObj{{"handler": "static_response", "status_code": 404}},
+		},
+	}
+	for i, rule := range d.Redirects {
+		pattern, placeholders, err := redirectPattern(rule.From)
+		if err != nil {
+			// this was checked when the rule was parsed
+			continue
+		}
+		matcherName := fmt.Sprintf("redirect%d", i)
+		to := redirectPlaceholder.ReplaceAllStringFunc(rule.To, func(placeholder string) string {
+			index := slices.Index(placeholders, placeholder[1:])
+			return fmt.Sprintf("{http.regexp.%s.%d}", matcherName, index+1)
+		})
+
+		matcher := utils.JsonObj{
+			"path_regexp": utils.JsonObj{"name": matcherName, "pattern": pattern},
+		}
+		if !rule.Force {
+			matcher["not"] = []utils.JsonObj{
+				{"file": utils.JsonObj{"try_files": []string{"{http.request.uri.path}"}}},
+			}
+		}
+
+		var handle []utils.JsonObj
+		switch rule.Status {
+		case 200:
+			handle = []utils.JsonObj{{"handler": "rewrite", "uri": to}}
+		case 404:
+			handle = []utils.JsonObj{
+				{"handler": "rewrite", "uri": to},
+				{"handler": "file_server", "status_code": 404},
+			}
+		default:
+			if strings.HasPrefix(to, "/") {
+				to = locationPrefix + to
+			}
+			handle = []utils.JsonObj{{
+				"handler":     "static_response",
+				"status_code": rule.Status,
+				"headers":     utils.JsonObj{"Location": []string{to}},
+			}}
+		}
+
+		// only the first rule that matches a request should apply, which is
+		// what caddy does for routes in the same group
+		subroutes = append(subroutes, utils.JsonObj{
+			"group":  "redirects",
+			"match":  []utils.JsonObj{matcher},
+			"handle": handle,
+		})
+	}
+
+	return subroutes
+}
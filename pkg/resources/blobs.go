@@ -0,0 +1,258 @@
+package resources
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gosimple/slug"
+)
+
+var blobHash = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// the directory inside the blob store that records which deployments have
+// uploaded which blobs
+const blobClaimsDirectory = "claims"
+
+// one file in a manifest-based upload: where the file goes in the deployment
+// and the sha256 hash of its contents
+type ManifestEntry struct {
+	Path string
+	Hash string
+}
+
+// the BlobStore keeps every file that's been uploaded through a manifest
+// exactly once, named after the sha256 hash of its contents, at [data
+// directory]/.blobs/[first two characters of hash]/[hash]. the directory for
+// each deployment is then built out of hard links to the blobs, so files that
+// don't change between deployments don't need to be uploaded or stored again.
+//
+// blobs are only visible to the deployments that uploaded them. each upload is
+// recorded as an empty "claim" file at [data directory]/.blobs/claims/
+// [deployment slug]/[hash], and a deployment can only check for or use the
+// blobs that it has a claim on, so being able to deploy to one site doesn't
+// reveal (or let you serve) the files that were uploaded to another one
+type BlobStore struct {
+	files *FileManager
+}
+
+func NewBlobStore(files *FileManager) *BlobStore {
+	return &BlobStore{files: files}
+}
+
+func (b *BlobStore) blobPath(hash string) string {
+	return path.Join(b.files.BlobsPath, hash[0:2], hash)
+}
+
+func (b *BlobStore) claimsPath(contentName string) string {
+	return path.Join(b.files.BlobsPath, blobClaimsDirectory, slug.Make(contentName))
+}
+
+func (b *BlobStore) has(hash string) bool {
+	_, err := os.Stat(b.blobPath(hash))
+	return err == nil
+}
+
+func (b *BlobStore) hasClaim(hash string, contentName string) bool {
+	_, err := os.Stat(path.Join(b.claimsPath(contentName), hash))
+	return err == nil
+}
+
+func (b *BlobStore) claim(hash string, contentName string) error {
+	if err := os.MkdirAll(b.claimsPath(contentName), 0750); err != nil {
+		return err
+	}
+	claimFile, err := os.Create(path.Join(b.claimsPath(contentName), hash))
+	if err != nil {
+		return fmt.Errorf("could not record blob: %w", err)
+	}
+	return claimFile.Close()
+}
+
+// returns the hashes that the deployment hasn't uploaded a blob for yet,
+// without duplicates. blobs that were only uploaded for other deployments
+// count as missing
+func (b *BlobStore) Missing(hashes []string, contentName string) []string {
+	missing := []string{}
+	for _, hash := range hashes {
+		if !slices.Contains(missing, hash) &&
+			(!b.hasClaim(hash, contentName) || !b.has(hash)) {
+			missing = append(missing, hash)
+		}
+	}
+	return missing
+}
+
+// forgets which blobs the deployment has uploaded, so that a deployment that's
+// created later with the same name doesn't get access to them
+func (b *BlobStore) ForgetClaims(contentName string) error {
+	return os.RemoveAll(b.claimsPath(contentName))
+}
+
+// stores the contents of the stream as a blob for the deployment and returns
+// its hash
+func (b *BlobStore) Put(stream io.Reader, contentName string) (string, error) {
+	if err := os.MkdirAll(b.files.BlobsPath, 0750); err != nil {
+		return "", err
+	}
+	// the blob is written to a temporary file first so that a half-written
+	// blob can never be mistaken for a complete one
+	tempFile, err := os.CreateTemp(b.files.BlobsPath, "upload-")
+	if err != nil {
+		return "", fmt.Errorf("could not create blob: %w", err)
+	}
+	defer os.Remove(tempFile.Name())
+	defer tempFile.Close()
+
+	hashWriter := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tempFile, hashWriter), stream); err != nil {
+		return "", fmt.Errorf("could not write blob: %w", err)
+	}
+	if err := tempFile.Close(); err != nil {
+		return "", fmt.Errorf("could not write blob: %w", err)
+	}
+	hash := hex.EncodeToString(hashWriter.Sum(nil))
+
+	if b.has(hash) {
+		return hash, b.claim(hash, contentName)
+	}
+	if err := os.MkdirAll(filepath.Dir(b.blobPath(hash)), 0750); err != nil {
+		return "", err
+	}
+	// blobs are shared between deployments through hard links, so they can't
+	// be allowed to change
+	if err := os.Chmod(tempFile.Name(), 0444); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tempFile.Name(), b.blobPath(hash)); err != nil {
+		return "", fmt.Errorf("could not store blob: %w", err)
+	}
+	return hash, b.claim(hash, contentName)
+}
+
+// checks that the manifest's paths are safe to create and that its hashes
+// look like sha256 hashes
+func ValidateManifest(manifest []ManifestEntry) error {
+	if len(manifest) == 0 {
+		return fmt.Errorf("manifest is empty")
+	}
+	seen := map[string]bool{}
+	for _, entry := range manifest {
+		if !filepath.IsLocal(entry.Path) || strings.Contains(entry.Path, "\\") {
+			return fmt.Errorf("%s is not a local file path", entry.Path)
+		}
+		cleanPath := path.Clean(entry.Path)
+		if seen[cleanPath] {
+			return fmt.Errorf("%s appears in the manifest more than once", entry.Path)
+		}
+		seen[cleanPath] = true
+		if !blobHash.MatchString(entry.Hash) {
+			return fmt.Errorf("%s is not a sha256 hash", entry.Hash)
+		}
+	}
+	return nil
+}
+
+// the md5 hash of the manifest's contents, which is used as the name of the
+// directory that the manifest's files are put in (just like the md5 hash of
+// an uploaded archive is)
+func manifestHash(manifest []ManifestEntry) string {
+	lines := []string{}
+	for _, entry := range manifest {
+		lines = append(lines, path.Clean(entry.Path)+"\x00"+entry.Hash)
+	}
+	slices.Sort(lines)
+	hash := md5.Sum([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(hash[:])
+}
+
+// creates a directory for the deployment that contains the files described by
+// the manifest, which all have to have been uploaded for the deployment
+// already
+func (b *BlobStore) ManifestToDeploymentFiles(
+	manifest []ManifestEntry, contentName string,
+) (DeploymentFiles, error) {
+	if err := ValidateManifest(manifest); err != nil {
+		return DeploymentFiles{}, err
+	}
+	hashes := []string{}
+	for _, entry := range manifest {
+		hashes = append(hashes, entry.Hash)
+	}
+	if missing := b.Missing(hashes, contentName); len(missing) > 0 {
+		return DeploymentFiles{}, fmt.Errorf("%d files in the manifest have not been uploaded", len(missing))
+	}
+
+	hash := manifestHash(manifest)
+	deploymentDir := path.Join(b.files.config.DataDirectory, slug.Make(contentName))
+	outDir := path.Join(deploymentDir, hash)
+
+	var size int64
+	for _, entry := range manifest {
+		if info, err := os.Stat(b.blobPath(entry.Hash)); err == nil {
+			size += info.Size()
+		}
+	}
+
+	// the exact same set of files was already deployed before
+	if _, err := os.Stat(outDir); err == nil {
+		return DeploymentFiles{Path: outDir, Hash: hash, Size: size}, nil
+	}
+
+	// the tree is built in a staging directory and then renamed into place,
+	// so it's never served half-built
+	if err := os.MkdirAll(deploymentDir, 0750); err != nil {
+		return DeploymentFiles{}, err
+	}
+	stagingDir, err := os.MkdirTemp(deploymentDir, "staging-")
+	if err != nil {
+		return DeploymentFiles{}, err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	for _, entry := range manifest {
+		outPath := path.Join(stagingDir, path.Clean(entry.Path))
+		if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+			return DeploymentFiles{}, err
+		}
+		if err := linkOrCopy(b.blobPath(entry.Hash), outPath); err != nil {
+			return DeploymentFiles{}, fmt.Errorf("could not create %s: %w", entry.Path, err)
+		}
+	}
+	if err := os.Chmod(stagingDir, 0755); err != nil {
+		return DeploymentFiles{}, err
+	}
+	if err := os.Rename(stagingDir, outDir); err != nil {
+		return DeploymentFiles{}, fmt.Errorf("could not move files into place: %w", err)
+	}
+
+	return DeploymentFiles{Path: outDir, Hash: hash, Size: size}, nil
+}
+
+// hard links don't work across filesystems (or on some filesystems at all), in
+// which case the blob is just copied
+func linkOrCopy(source string, dest string) error {
+	if err := os.Link(source, dest); err == nil {
+		return nil
+	}
+	sourceFile, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer sourceFile.Close()
+	destFile, err := os.OpenFile(dest, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer destFile.Close()
+	_, err = io.Copy(destFile, sourceFile)
+	return err
+}
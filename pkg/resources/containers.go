@@ -1,3 +1,389 @@
 package resources
 
-// TODO: put container manager here
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/docker/go-connections/nat"
+	"github.com/gosimple/slug"
+	"github.com/internet-golf/internet-golf/pkg/utils"
+)
+
+// describes a container that should be running for a deployment
+type ContainerSpec struct {
+	// name of the image that the container is created from, like "nginx:latest"
+	Image string
+	// port that the process inside the container listens on
+	InternalPort int
+	// if this is not nil, the image is loaded from this stream (which should
+	// contain the output of `docker save`) instead of being pulled from a
+	// registry
+	ImageArchive io.Reader
+}
+
+type managedContainer struct {
+	id string
+	// the host:port address that Start returned for the container
+	address string
+	// cancels the goroutine that restarts the container when it exits
+	stopWatching context.CancelFunc
+}
+
+// the ContainerManager runs docker containers for deployments, keeps them
+// running, and gets rid of them when they're no longer needed. it talks to
+// the docker engine api, which means that it can be pointed at anything that
+// implements that api (including a fake one, in tests.)
+type ContainerManager struct {
+	client *client.Client
+	// the containers for each piece of content, oldest first. there's usually
+	// only one, except while a deployment is switching to a new one
+	containers map[string][]*managedContainer
+	mutex      sync.Mutex
+}
+
+// how long to wait before restarting a container that has exited on its own.
+// this doubles after each consecutive crash up to maxRestartDelay
+const (
+	initialRestartDelay = 500 * time.Millisecond
+	maxRestartDelay     = 30 * time.Second
+)
+
+// creates a container manager that talks to the docker engine at dockerHost
+// (like "unix:///var/run/docker.sock".) if dockerHost is empty, the usual
+// docker environment variables are used, and if those are empty, the default
+// docker socket is used. note that this does not actually connect to anything
+// yet, so it's fine to call this on a machine that does not have docker
+func NewContainerManager(dockerHost string) (*ContainerManager, error) {
+	opts := []client.Opt{client.FromEnv, client.WithAPIVersionNegotiation()}
+	if len(dockerHost) > 0 {
+		opts = append(opts, client.WithHost(dockerHost))
+	}
+	cli, err := client.NewClientWithOpts(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create docker client: %w", err)
+	}
+	return &ContainerManager{
+		client:     cli,
+		containers: map[string][]*managedContainer{},
+	}, nil
+}
+
+// containers are labeled with their content name, so that the ones left over
+// from a previous run of the server can be found
+const contentLabel = "internet-golf.content"
+
+// the hash is there because different content names can have the same slug,
+// and the port is there because there can be more than one container for the
+// same content at once
+func containerName(contentName string, hostPort int) string {
+	hash := md5.Sum([]byte(contentName))
+	return fmt.Sprintf(
+		"golf-%s-%s-%d", slug.Make(contentName), hex.EncodeToString(hash[0:4]), hostPort,
+	)
+}
+
+// makes sure that the image for the spec is available to the docker engine. if
+// the spec includes an image archive, that is loaded; otherwise, the image is
+// pulled, unless pullOnlyIfMissing is true and the image is already present
+func (c *ContainerManager) prepareImage(
+	ctx context.Context, spec ContainerSpec, pullOnlyIfMissing bool,
+) error {
+	if spec.ImageArchive != nil {
+		resp, err := c.client.ImageLoad(ctx, spec.ImageArchive)
+		if err != nil {
+			return fmt.Errorf("could not load image: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.JSON {
+			return jsonmessage.DisplayJSONMessagesStream(resp.Body, io.Discard, 0, false, nil)
+		}
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	}
+
+	if pullOnlyIfMissing {
+		if _, err := c.client.ImageInspect(ctx, spec.Image); err == nil {
+			return nil
+		}
+	}
+
+	reader, err := c.client.ImagePull(ctx, spec.Image, image.PullOptions{})
+	if err != nil {
+		return fmt.Errorf("could not pull image %s: %w", spec.Image, err)
+	}
+	defer reader.Close()
+	// the pull is only done once this stream has been read all the way through;
+	// this also turns any errors that show up in the stream into go errors
+	return jsonmessage.DisplayJSONMessagesStream(reader, io.Discard, 0, false, nil)
+}
+
+// starts a container for the content named contentName (which should be unique
+// for each deployment) and returns the host:port address that it can be reached
+// at. any container that was previously started for the content keeps running,
+// so that the deployment can keep using it until it has switched to the new
+// one; after that, StopPrevious should be called, or, if switching failed,
+// Discard.
+//
+// if isRestore is true, this is assumed to be recreating a container that was
+// set up in a previous run of the server, so the image will only be pulled if
+// it isn't already present, and containers left over from that run are removed
+func (c *ContainerManager) Start(
+	contentName string, spec ContainerSpec, isRestore bool,
+) (string, error) {
+	if spec.InternalPort <= 0 {
+		return "", fmt.Errorf("invalid container port %d", spec.InternalPort)
+	}
+
+	ctx := context.Background()
+
+	if err := c.prepareImage(ctx, spec, isRestore); err != nil {
+		return "", err
+	}
+
+	if isRestore {
+		if err := c.removeLeftovers(ctx, contentName); err != nil {
+			return "", err
+		}
+	}
+
+	hostPort, err := utils.GetFreePort()
+	if err != nil {
+		return "", fmt.Errorf("could not find a free port for container: %w", err)
+	}
+
+	internalPort, err := nat.NewPort("tcp", strconv.Itoa(spec.InternalPort))
+	if err != nil {
+		return "", err
+	}
+
+	created, err := c.client.ContainerCreate(
+		ctx,
+		&container.Config{
+			Image:        spec.Image,
+			ExposedPorts: nat.PortSet{internalPort: struct{}{}},
+			Labels:       map[string]string{contentLabel: contentName},
+		},
+		&container.HostConfig{
+			PortBindings: nat.PortMap{
+				internalPort: []nat.PortBinding{
+					// only bind to localhost; the outside world should go
+					// through caddy
+					{HostIP: "127.0.0.1", HostPort: strconv.Itoa(hostPort)},
+				},
+			},
+		},
+		nil,
+		nil,
+		containerName(contentName, hostPort),
+	)
+	if err != nil {
+		return "", fmt.Errorf("could not create container: %w", err)
+	}
+
+	if err := c.client.ContainerStart(ctx, created.ID, container.StartOptions{}); err != nil {
+		c.client.ContainerRemove(ctx, created.ID, container.RemoveOptions{Force: true})
+		return "", fmt.Errorf("could not start container: %w", err)
+	}
+
+	address := "127.0.0.1:" + strconv.Itoa(hostPort)
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	c.mutex.Lock()
+	c.containers[contentName] = append(c.containers[contentName], &managedContainer{
+		id:           created.ID,
+		address:      address,
+		stopWatching: stopWatching,
+	})
+	c.mutex.Unlock()
+
+	go c.restartOnExit(watchCtx, contentName, created.ID)
+
+	return address, nil
+}
+
+// waits for the container to stop running and then starts it again, forever,
+// or at least until ctx is cancelled (which happens when the container is
+// stopped on purpose)
+func (c *ContainerManager) restartOnExit(ctx context.Context, contentName string, id string) {
+	delay := initialRestartDelay
+	startedAt := time.Now()
+	for {
+		statusCh, errCh := c.client.ContainerWait(ctx, id, container.WaitConditionNotRunning)
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-errCh:
+			if ctx.Err() != nil {
+				return
+			}
+			fmt.Fprintf(os.Stderr, "error waiting for container for %s: %v\n", contentName, err)
+		case status := <-statusCh:
+			if ctx.Err() != nil {
+				return
+			}
+			fmt.Fprintf(os.Stderr, "container for %s exited with status %d\n", contentName, status.StatusCode)
+		}
+
+		// if the container was up for a while, it's probably not stuck in a
+		// crash loop, so it doesn't need to wait very long
+		if time.Since(startedAt) > time.Minute {
+			delay = initialRestartDelay
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		startedAt = time.Now()
+		if err := c.client.ContainerStart(ctx, id, container.StartOptions{}); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			fmt.Fprintf(os.Stderr, "could not restart container for %s: %v\n", contentName, err)
+		}
+		delay = min(delay*2, maxRestartDelay)
+	}
+}
+
+// stops and removes all of the containers for the content named contentName,
+// including any left over from a previous run of the server. does nothing if
+// there are no such containers
+func (c *ContainerManager) Stop(contentName string) error {
+	c.mutex.Lock()
+	existing := c.containers[contentName]
+	delete(c.containers, contentName)
+	c.mutex.Unlock()
+
+	ctx := context.Background()
+	for _, container := range existing {
+		container.stopWatching()
+		if err := c.removeContainer(ctx, container.id); err != nil {
+			return err
+		}
+	}
+	return c.removeLeftovers(ctx, contentName)
+}
+
+// stops and removes the containers for the content named contentName that were
+// started before the one at address, which the deployment has switched to
+func (c *ContainerManager) StopPrevious(contentName string, address string) error {
+	c.mutex.Lock()
+	containers := c.containers[contentName]
+	index := slices.IndexFunc(containers, func(m *managedContainer) bool {
+		return m.address == address
+	})
+	if index == -1 {
+		c.mutex.Unlock()
+		return fmt.Errorf("there's no container at %s for %s", address, contentName)
+	}
+	previous := containers[:index]
+	c.containers[contentName] = slices.Clone(containers[index:])
+	c.mutex.Unlock()
+
+	ctx := context.Background()
+	for _, container := range previous {
+		container.stopWatching()
+		if err := c.removeContainer(ctx, container.id); err != nil {
+			return err
+		}
+	}
+	return c.removeLeftovers(ctx, contentName)
+}
+
+// stops and removes the container at address, which was started for the
+// content named contentName but ended up not being used
+func (c *ContainerManager) Discard(contentName string, address string) error {
+	c.mutex.Lock()
+	var discarded *managedContainer
+	c.containers[contentName] = slices.DeleteFunc(c.containers[contentName], func(m *managedContainer) bool {
+		if m.address == address {
+			discarded = m
+			return true
+		}
+		return false
+	})
+	if len(c.containers[contentName]) == 0 {
+		delete(c.containers, contentName)
+	}
+	c.mutex.Unlock()
+
+	if discarded == nil {
+		return nil
+	}
+	discarded.stopWatching()
+	return c.removeContainer(context.Background(), discarded.id)
+}
+
+// removes the containers for the content named contentName that this manager
+// isn't keeping track of, which were left over from a previous run of the
+// server
+func (c *ContainerManager) removeLeftovers(ctx context.Context, contentName string) error {
+	found, err := c.client.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", contentLabel+"="+contentName)),
+	})
+	if err != nil {
+		return fmt.Errorf("could not list containers for %s: %w", contentName, err)
+	}
+
+	c.mutex.Lock()
+	tracked := map[string]bool{}
+	for _, container := range c.containers[contentName] {
+		tracked[container.id] = true
+	}
+	c.mutex.Unlock()
+
+	for _, container := range found {
+		if tracked[container.ID] {
+			continue
+		}
+		if err := c.removeContainer(ctx, container.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ContainerManager) removeContainer(ctx context.Context, id string) error {
+	// give the container a few seconds to shut down gracefully
+	timeout := 5
+	if err := c.client.ContainerStop(ctx, id, container.StopOptions{Timeout: &timeout}); err != nil &&
+		!client.IsErrNotFound(err) {
+		fmt.Fprintf(os.Stderr, "could not stop container %s: %v\n", id, err)
+	}
+	err := c.client.ContainerRemove(ctx, id, container.RemoveOptions{Force: true})
+	if err != nil && !client.IsErrNotFound(err) {
+		return fmt.Errorf("could not remove container %s: %w", id, err)
+	}
+	return nil
+}
+
+// stops all of the containers that this manager is responsible for
+func (c *ContainerManager) StopAll() {
+	c.mutex.Lock()
+	names := make([]string, 0, len(c.containers))
+	for name := range c.containers {
+		names = append(names, name)
+	}
+	c.mutex.Unlock()
+
+	for _, name := range names {
+		if err := c.Stop(name); err != nil {
+			fmt.Fprintf(os.Stderr, "could not stop container for %s: %v\n", name, err)
+		}
+	}
+}
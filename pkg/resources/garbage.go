@@ -0,0 +1,209 @@
+package resources
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// the directories that uploads are extracted to are named after the md5 hash of
+// the upload, so they're easy to tell apart from everything else in the data
+// directory
+var contentDirectoryName = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// a content directory (or blob) that nothing refers to anymore
+type OrphanedDirectory struct {
+	Path string
+	// total size of the files in the directory, in bytes
+	Size int64
+	// when the garbage collector first noticed that nothing refers to this
+	// directory
+	OrphanedSince time.Time
+}
+
+type GarbageReport struct {
+	// directories that were deleted (or, for a dry run, that would have been)
+	Deleted []OrphanedDirectory
+	// directories that are orphaned but are still within the grace period
+	Pending []OrphanedDirectory
+}
+
+// the GarbageCollector finds the content directories in the data directory
+// (the ones at [data directory]/[deployment slug]/[hash]) that are no longer
+// used by any deployment and deletes them. a directory has to stay unused for
+// the length of the grace period before it's deleted, so that requests that
+// are still being served from it when it's replaced have time to finish
+type GarbageCollector struct {
+	files       *FileManager
+	gracePeriod time.Duration
+	// when each currently orphaned directory was first found to be orphaned
+	orphanedSince map[string]time.Time
+	mutex         sync.Mutex
+}
+
+func NewGarbageCollector(files *FileManager, gracePeriod time.Duration) *GarbageCollector {
+	return &GarbageCollector{
+		files:         files,
+		gracePeriod:   gracePeriod,
+		orphanedSince: map[string]time.Time{},
+	}
+}
+
+// returns all of the content directories in the data directory
+func (g *GarbageCollector) findContentDirectories() ([]string, error) {
+	dataDir := g.files.config.DataDirectory
+	slugDirs, err := os.ReadDir(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	contentDirs := []string{}
+	for _, slugDir := range slugDirs {
+		if !slugDir.IsDir() {
+			continue
+		}
+		entries, err := os.ReadDir(path.Join(dataDir, slugDir.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() && contentDirectoryName.MatchString(entry.Name()) {
+				contentDirs = append(contentDirs, path.Join(dataDir, slugDir.Name(), entry.Name()))
+			}
+		}
+	}
+	return contentDirs, nil
+}
+
+// returns the blobs in the blob store that no deployment directory is linked
+// to. this only works on systems that report how many hard links a file has;
+// elsewhere, blobs are kept forever
+func (g *GarbageCollector) findUnlinkedBlobs() []string {
+	blobs := []string{}
+	filepath.WalkDir(g.files.BlobsPath, func(p string, d fs.DirEntry, err error) error {
+		// claims are just markers, not blobs
+		if err == nil && d.IsDir() && p == filepath.Join(g.files.BlobsPath, blobClaimsDirectory) {
+			return filepath.SkipDir
+		}
+		if err != nil || d.IsDir() || !blobHash.MatchString(d.Name()) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		if links, ok := linkCount(info); ok && links <= 1 {
+			blobs = append(blobs, filepath.Clean(p))
+		}
+		return nil
+	})
+	return blobs
+}
+
+func directorySize(dir string) int64 {
+	var size int64
+	filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	return size
+}
+
+// deletes the content directories that aren't referred to by any of the paths
+// in `referenced` and that have been orphaned for longer than the grace period.
+// a directory counts as referenced if one of the paths is the directory itself
+// or something inside of it. blobs that no content directory links to anymore
+// are deleted the same way. if dryRun is true, nothing is actually deleted
+func (g *GarbageCollector) Collect(referenced []string, dryRun bool) (GarbageReport, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	contentDirs, err := g.findContentDirectories()
+	if err != nil {
+		return GarbageReport{}, fmt.Errorf("could not search data directory: %w", err)
+	}
+
+	isReferenced := func(dir string) bool {
+		return slices.ContainsFunc(referenced, func(r string) bool {
+			r = filepath.Clean(r)
+			return r == dir || strings.HasPrefix(r, dir+string(filepath.Separator))
+		})
+	}
+
+	now := time.Now()
+	report := GarbageReport{Deleted: []OrphanedDirectory{}, Pending: []OrphanedDirectory{}}
+	stillOrphaned := map[string]time.Time{}
+
+	for _, dir := range contentDirs {
+		dir = filepath.Clean(dir)
+		if isReferenced(dir) {
+			continue
+		}
+
+		orphanedSince, known := g.orphanedSince[dir]
+		if !known {
+			orphanedSince = now
+		}
+		orphan := OrphanedDirectory{Path: dir, Size: directorySize(dir), OrphanedSince: orphanedSince}
+
+		if now.Sub(orphanedSince) < g.gracePeriod {
+			report.Pending = append(report.Pending, orphan)
+			stillOrphaned[dir] = orphanedSince
+			continue
+		}
+
+		if dryRun {
+			report.Deleted = append(report.Deleted, orphan)
+			stillOrphaned[dir] = orphanedSince
+			continue
+		}
+
+		if err := os.RemoveAll(dir); err != nil {
+			fmt.Fprintf(os.Stderr, "could not delete %s: %v\n", dir, err)
+			stillOrphaned[dir] = orphanedSince
+			continue
+		}
+		report.Deleted = append(report.Deleted, orphan)
+		// this only succeeds if the deployment's directory is now empty, which
+		// is what we want
+		os.Remove(filepath.Dir(dir))
+	}
+
+	for _, blob := range g.findUnlinkedBlobs() {
+		orphanedSince, known := g.orphanedSince[blob]
+		if !known {
+			orphanedSince = now
+		}
+		orphan := OrphanedDirectory{Path: blob, Size: directorySize(blob), OrphanedSince: orphanedSince}
+
+		if now.Sub(orphanedSince) < g.gracePeriod {
+			report.Pending = append(report.Pending, orphan)
+			stillOrphaned[blob] = orphanedSince
+		} else if dryRun {
+			report.Deleted = append(report.Deleted, orphan)
+			stillOrphaned[blob] = orphanedSince
+		} else if err := os.Remove(blob); err != nil {
+			fmt.Fprintf(os.Stderr, "could not delete %s: %v\n", blob, err)
+			stillOrphaned[blob] = orphanedSince
+		} else {
+			report.Deleted = append(report.Deleted, orphan)
+		}
+	}
+
+	// a dry run still counts as noticing the orphaned directories, so that the
+	// grace period for them starts
+	g.orphanedSince = stillOrphaned
+
+	return report, nil
+}
@@ -0,0 +1,10 @@
+//go:build !unix
+
+package resources
+
+import "io/fs"
+
+// hard link counts aren't available here
+func linkCount(info fs.FileInfo) (uint64, bool) {
+	return 0, false
+}
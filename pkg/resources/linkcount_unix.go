@@ -0,0 +1,17 @@
+//go:build unix
+
+package resources
+
+import (
+	"io/fs"
+	"syscall"
+)
+
+// returns the number of hard links to the file
+func linkCount(info fs.FileInfo) (uint64, bool) {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, false
+	}
+	return uint64(stat.Nlink), true
+}
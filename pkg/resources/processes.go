@@ -0,0 +1,302 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/internet-golf/internet-golf/pkg/utils"
+)
+
+// how much output from each process is kept around to be read through the api.
+// older output is discarded
+const processLogSize = 64 * 1024
+
+// fixed-size buffer that keeps the last processLogSize bytes written to it.
+// used to capture the stdout and stderr of a process
+type logBuffer struct {
+	data []byte
+	// position in data that the next write will start at
+	next int
+	// whether data has been filled up and wrapped around at least once
+	full  bool
+	mutex sync.Mutex
+}
+
+func newLogBuffer(size int) *logBuffer {
+	return &logBuffer{data: make([]byte, size)}
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	written := len(p)
+	// if there's more than fits in the buffer, only the end of it matters
+	if len(p) > len(b.data) {
+		p = p[len(p)-len(b.data):]
+	}
+	for len(p) > 0 {
+		n := copy(b.data[b.next:], p)
+		p = p[n:]
+		b.next += n
+		if b.next == len(b.data) {
+			b.next = 0
+			b.full = true
+		}
+	}
+	return written, nil
+}
+
+// returns a copy of the buffer's contents, oldest first
+func (b *logBuffer) Bytes() []byte {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if !b.full {
+		return append([]byte{}, b.data[:b.next]...)
+	}
+	return append(append([]byte{}, b.data[b.next:]...), b.data[:b.next]...)
+}
+
+type managedProcess struct {
+	// the host:port address that Start returned for the process
+	address string
+	logs    *logBuffer
+	// cancels the goroutine that keeps the process running (and kills the
+	// process)
+	stop context.CancelFunc
+	// closed once the supervising goroutine has exited and the process is dead
+	done chan struct{}
+}
+
+// the ProcessManager runs executables for deployments as child processes of
+// the server, restarts them when they crash, and keeps track of their output
+type ProcessManager struct {
+	// the processes for each piece of content, oldest first. there's usually
+	// only one, except while a deployment is switching to a new one
+	processes map[string][]*managedProcess
+	mutex     sync.Mutex
+}
+
+func NewProcessManager() *ProcessManager {
+	return &ProcessManager{processes: map[string][]*managedProcess{}}
+}
+
+// starts the executable at the given path for the content named contentName
+// (which should be unique for each deployment). the process is run in the
+// executable's directory and receives the port that it should listen on in the
+// PORT environment variable. returns the host:port address that the process can
+// be reached at. like with containers, any process that was previously started
+// for the content keeps running until StopPrevious is called (or Discard, if
+// the deployment couldn't switch to the new one)
+func (p *ProcessManager) Start(contentName string, executable string) (string, error) {
+	if info, err := os.Stat(executable); err != nil {
+		return "", fmt.Errorf("could not find executable: %w", err)
+	} else if info.IsDir() {
+		return "", fmt.Errorf("executable %s is a directory", executable)
+	}
+
+	port, err := utils.GetFreePort()
+	if err != nil {
+		return "", fmt.Errorf("could not find a free port for process: %w", err)
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	process := &managedProcess{
+		address: "127.0.0.1:" + strconv.Itoa(port),
+		logs:    newLogBuffer(processLogSize),
+		stop:    stop,
+		done:    make(chan struct{}),
+	}
+
+	// start the process once up front so that problems like a bad executable
+	// format can be reported right away
+	cmd := p.command(executable, port, process.logs)
+	if err := cmd.Start(); err != nil {
+		stop()
+		return "", fmt.Errorf("could not start process: %w", err)
+	}
+
+	p.mutex.Lock()
+	p.processes[contentName] = append(p.processes[contentName], process)
+	p.mutex.Unlock()
+
+	go p.supervise(ctx, contentName, process, cmd, executable, port)
+
+	return process.address, nil
+}
+
+func (p *ProcessManager) command(executable string, port int, logs *logBuffer) *exec.Cmd {
+	cmd := exec.Command(executable)
+	cmd.Dir = filepath.Dir(executable)
+	cmd.Env = processEnv(port)
+	cmd.Stdout = logs
+	cmd.Stderr = logs
+	return cmd
+}
+
+// the environment that processes are started with. this doesn't include the
+// server's own environment, since that might have secrets in it
+func processEnv(port int) []string {
+	env := []string{"PORT=" + strconv.Itoa(port)}
+	for _, name := range []string{"PATH", "LANG", "TZ"} {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	return env
+}
+
+// waits for the process to exit and starts it again, forever, or at least until
+// ctx is cancelled (which happens when the process is stopped on purpose)
+func (p *ProcessManager) supervise(
+	ctx context.Context, contentName string, process *managedProcess,
+	cmd *exec.Cmd, executable string, port int,
+) {
+	defer close(process.done)
+
+	delay := initialRestartDelay
+	for {
+		startedAt := time.Now()
+		exited := make(chan error, 1)
+		if cmd != nil {
+			go func() { exited <- cmd.Wait() }()
+		} else {
+			exited <- fmt.Errorf("process was not running")
+		}
+
+		select {
+		case <-ctx.Done():
+			if cmd != nil {
+				// give the process a few seconds to shut down gracefully. (this
+				// signal isn't supported on windows, so it just gets killed)
+				if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+					cmd.Process.Kill()
+				}
+				select {
+				case <-exited:
+				case <-time.After(5 * time.Second):
+					cmd.Process.Kill()
+					<-exited
+				}
+			}
+			return
+		case err := <-exited:
+			fmt.Fprintf(process.logs, "[golf] process for %s exited: %v\n", contentName, err)
+		}
+
+		// if the process was up for a while, it's probably not stuck in a
+		// crash loop, so it doesn't need to wait very long
+		if time.Since(startedAt) > time.Minute {
+			delay = initialRestartDelay
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxRestartDelay)
+
+		cmd = p.command(executable, port, process.logs)
+		if err := cmd.Start(); err != nil {
+			fmt.Fprintf(process.logs, "[golf] could not restart process for %s: %v\n", contentName, err)
+			cmd = nil
+		}
+	}
+}
+
+// stops the processes for the content named contentName and waits for them to
+// exit. does nothing if there are no such processes
+func (p *ProcessManager) Stop(contentName string) {
+	p.mutex.Lock()
+	existing := p.processes[contentName]
+	delete(p.processes, contentName)
+	p.mutex.Unlock()
+
+	stopProcesses(existing)
+}
+
+// stops the processes for the content named contentName that were started
+// before the one at address, which the deployment has switched to
+func (p *ProcessManager) StopPrevious(contentName string, address string) error {
+	p.mutex.Lock()
+	processes := p.processes[contentName]
+	index := slices.IndexFunc(processes, func(m *managedProcess) bool {
+		return m.address == address
+	})
+	if index == -1 {
+		p.mutex.Unlock()
+		return fmt.Errorf("there's no process at %s for %s", address, contentName)
+	}
+	previous := processes[:index]
+	p.processes[contentName] = slices.Clone(processes[index:])
+	p.mutex.Unlock()
+
+	stopProcesses(previous)
+	return nil
+}
+
+// stops the process at address, which was started for the content named
+// contentName but ended up not being used
+func (p *ProcessManager) Discard(contentName string, address string) {
+	p.mutex.Lock()
+	var discarded []*managedProcess
+	p.processes[contentName] = slices.DeleteFunc(p.processes[contentName], func(m *managedProcess) bool {
+		if m.address == address {
+			discarded = append(discarded, m)
+			return true
+		}
+		return false
+	})
+	if len(p.processes[contentName]) == 0 {
+		delete(p.processes, contentName)
+	}
+	p.mutex.Unlock()
+
+	stopProcesses(discarded)
+}
+
+func stopProcesses(processes []*managedProcess) {
+	for _, process := range processes {
+		process.stop()
+	}
+	for _, process := range processes {
+		<-process.done
+	}
+}
+
+// stops all of the processes that this manager is responsible for
+func (p *ProcessManager) StopAll() {
+	p.mutex.Lock()
+	names := make([]string, 0, len(p.processes))
+	for name := range p.processes {
+		names = append(names, name)
+	}
+	p.mutex.Unlock()
+
+	for _, name := range names {
+		p.Stop(name)
+	}
+}
+
+// returns the most recent output (stdout and stderr, interleaved) of the newest
+// process for the content named contentName
+func (p *ProcessManager) Logs(contentName string) ([]byte, error) {
+	p.mutex.Lock()
+	processes := p.processes[contentName]
+	p.mutex.Unlock()
+
+	if len(processes) == 0 {
+		return nil, fmt.Errorf("no process is running for %s", contentName)
+	}
+	return processes[len(processes)-1].logs.Bytes(), nil
+}
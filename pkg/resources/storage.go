@@ -1,8 +1,7 @@
 package resources
 
 import (
-	"archive/tar"
-	"compress/gzip"
+	"context"
 	"crypto/md5"
 	"embed"
 	_ "embed"
@@ -13,10 +12,12 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/gosimple/slug"
 	"github.com/internet-golf/internet-golf/pkg/utils"
+	"github.com/mholt/archives"
 )
 
 //go:embed all:dash-dist/*
@@ -27,6 +28,7 @@ type FileManager struct {
 	DbPath        string
 	CaddyDataPath string
 	DashSpaPath   string
+	BlobsPath     string
 }
 
 func NewFileManager(config *utils.Config) *FileManager {
@@ -35,6 +37,9 @@ func NewFileManager(config *utils.Config) *FileManager {
 		DbPath:        path.Join(config.DataDirectory, "internet.db"),
 		CaddyDataPath: path.Join(config.DataDirectory, "caddy-internal"),
 		DashSpaPath:   path.Join(config.DataDirectory, "dashboard"),
+		// deployment directories are named after slugs, which can't start
+		// with a dot, so this can't clash with one
+		BlobsPath: path.Join(config.DataDirectory, ".blobs"),
 	}
 
 	writeOutEmbeddedFs(dash, "dash-dist", manager.DashSpaPath)
@@ -42,158 +47,412 @@ func NewFileManager(config *utils.Config) *FileManager {
 	return manager
 }
 
-// receives a stream of a .tar.gz file, extracts its contents according to the
-// settings, returns the path of the contents
-func (f FileManager) TarGzToDeploymentFiles(
-	stream io.ReadSeeker, contentName string, keepLeadingDirectories bool,
-) (string, error) {
-	hash, hashErr := hashStream(stream)
-	if hashErr != nil {
-		return "", fmt.Errorf("could not hash files for %s", contentName)
-	}
-	outDir := path.Join(
-		f.config.DataDirectory,
-		slug.Make(contentName),
-		hash,
-	)
-	// weirdly, formData.Contents is a seekable stream, which i'm pretty
-	// sure means its entire contents must be being kept in memory so that
-	// they can be sought back to (unless it falls back to saving them
-	// to disk for large files?) this seems like an annoying limitation
-	if tarGzError := extractTarGz(
-		stream, outDir, !keepLeadingDirectories,
-	); tarGzError != nil {
-		return "", tarGzError
-	}
-
-	// TODO: if len(preserveFromPreviousPath) > 0, copy everything from that
-	// previous path over into the new directory
-
-	return outDir, nil
-}
-
-// turns the contents of a stream into an md5 hash. seeks the stream back to its
-// start before and after computing the hash.
-func hashStream(stream io.ReadSeeker) (string, error) {
-	hashWriter := md5.New()
-	stream.Seek(0, 0)
-	defer stream.Seek(0, 0)
+// describes a set of files that was uploaded and extracted for a deployment
+type DeploymentFiles struct {
+	// the directory that the files were extracted to
+	Path string
+	// hash of the uploaded archive, which is also the name of the directory
+	Hash string
+	// size of the uploaded archive in bytes
+	Size int64
+}
 
-	written, err := io.Copy(hashWriter, stream)
+// receives a stream of an archive file, extracts its contents according to the
+// settings, returns where the contents ended up. the stream is only read once,
+// so the archive never has to be held in memory or saved anywhere
+func (f FileManager) ArchiveToDeploymentFiles(
+	stream io.Reader, contentName string, keepLeadingDirectories bool,
+) (DeploymentFiles, error) {
+	deploymentDir := path.Join(f.config.DataDirectory, slug.Make(contentName))
+	staged, err := f.stageArchive(stream, deploymentDir, !keepLeadingDirectories)
 	if err != nil {
+		return DeploymentFiles{}, err
+	}
+	defer os.RemoveAll(staged.stagingDir)
+
+	outDir := path.Join(deploymentDir, staged.hash)
+	if err := moveIntoPlace(staged.root, outDir); err != nil {
+		return DeploymentFiles{}, err
+	}
+	return DeploymentFiles{Path: outDir, Hash: staged.hash, Size: staged.size}, nil
+}
+
+// like ArchiveToDeploymentFiles, except that the files are extracted on top of a
+// copy of the files in previousPath, after the paths in deletions are removed
+// from that copy. the result goes in a new directory, so the previous files are
+// left alone and the deployment can switch over to the new ones all at once
+func (f FileManager) ArchiveOverDeploymentFiles(
+	stream io.Reader, contentName string, keepLeadingDirectories bool,
+	previousPath string, deletions []string,
+) (DeploymentFiles, error) {
+	for _, deletion := range deletions {
+		if !filepath.IsLocal(deletion) {
+			return DeploymentFiles{}, fmt.Errorf("cannot delete %s: not a local file path", deletion)
+		}
+	}
+
+	deploymentDir := path.Join(f.config.DataDirectory, slug.Make(contentName))
+	staged, err := f.stageArchive(stream, deploymentDir, !keepLeadingDirectories)
+	if err != nil {
+		return DeploymentFiles{}, err
+	}
+	defer os.RemoveAll(staged.stagingDir)
+
+	// the new directory's contents depend on the previous files and the
+	// deletions as well as the upload, so they all go into its name
+	sortedDeletions := slices.Clone(deletions)
+	slices.Sort(sortedDeletions)
+	combinedHash := md5.Sum([]byte(
+		staged.hash + "\n" + filepath.Base(previousPath) + "\n" + strings.Join(sortedDeletions, "\n"),
+	))
+	hash := hex.EncodeToString(combinedHash[:])
+
+	outDir := path.Join(deploymentDir, hash)
+	if _, err := os.Stat(outDir); err == nil {
+		return DeploymentFiles{Path: outDir, Hash: hash, Size: staged.size}, nil
+	}
+
+	tree := path.Join(staged.stagingDir, "tree")
+	if err := copyTree(previousPath, tree); err != nil {
+		return DeploymentFiles{}, fmt.Errorf("could not copy existing files: %w", err)
+	}
+	for _, deletion := range deletions {
+		if err := os.RemoveAll(path.Join(tree, deletion)); err != nil {
+			return DeploymentFiles{}, fmt.Errorf("could not delete %s: %w", deletion, err)
+		}
+	}
+	if err := moveTree(staged.root, tree); err != nil {
+		return DeploymentFiles{}, fmt.Errorf("could not add uploaded files: %w", err)
+	}
+
+	if err := moveIntoPlace(tree, outDir); err != nil {
+		return DeploymentFiles{}, err
+	}
+	return DeploymentFiles{Path: outDir, Hash: hash, Size: staged.size}, nil
+}
+
+// an archive that has been extracted into a staging directory, but that hasn't
+// been moved to where it'll be served from yet
+type stagedArchive struct {
+	// temporary directory that everything was extracted within; should be
+	// removed once the files have been moved out of it
+	stagingDir string
+	// the directory within stagingDir that holds the archive's files, after
+	// any leading directories that are being trimmed
+	root string
+	// md5 hash of the archive
+	hash string
+	// size of the archive in bytes
+	size int64
+}
+
+// creates a temporary directory within deploymentDir, so that it's on the
+// same filesystem as the directory that its contents end up being renamed to
+func newStagingDir(deploymentDir string) (string, error) {
+	if err := os.MkdirAll(deploymentDir, 0750); err != nil {
 		return "", err
 	}
-	fmt.Printf("hashed %v bytes\n", written)
-	return hex.EncodeToString(hashWriter.Sum(nil)), nil
+	return os.MkdirTemp(deploymentDir, "staging-")
 }
 
-// function that takes a stream containing .tar.gz data and extracts the files
-// and folders within to baseOutDir.
+// extracts the archive in the stream into a new staging directory within
+// deploymentDir, hashing it at the same time. if trimLeadingDirs is true,
+// parent directories at the top level that have no siblings and that contain
+// every other file in the archive within them are discarded (e.g. if the files
+// in the archive are ["dist/index.html", "dist/index.js", "dist/favicon.ico"],
+// the root of the result is the "dist/" directory.) this is generally what you
+// want.
 //
-// if trimLeadingDirs is true, parent directories at the top level that have no
-// siblings and that contain every other file in the tarball within them will be
-// discarded (e.g. if the files in the .tar.gz are ["dist/index.html",
-// "dist/index.js", "dist/favicon.ico"], it will discard the "dist/" and just
-// create the files ["index.html", "index.js", "favicon.ico"]). this is
-// generally what you want.
-//
-// the tar file traversal is heavily referenced from
-// https://stackoverflow.com/a/57640231/3962267
-//
-// TODO: would probably be easier with
-// https://github.com/mholt/archives?tab=readme-ov-file#extract-archive
-func extractTarGz(gzipStream io.ReadSeeker, baseOutDir string, trimLeadingDirs bool) error {
-	os.MkdirAll(baseOutDir, 0750)
-
-	uncompressedStream, err := gzip.NewReader(gzipStream)
+// since the leading directories can't be known until the whole archive has
+// been read, they're trimmed by pointing root at the right directory
+// afterwards, which the files are then renamed out of
+func (f FileManager) stageArchive(
+	stream io.Reader, deploymentDir string, trimLeadingDirs bool,
+) (stagedArchive, error) {
+	stagingDir, err := newStagingDir(deploymentDir)
 	if err != nil {
-		return fmt.Errorf("ExtractTarGz: NewReader failed")
+		return stagedArchive{}, err
 	}
 
-	tarReader := tar.NewReader(uncompressedStream)
+	hashWriter := md5.New()
+	counter := &countingWriter{}
+	hashedStream := io.TeeReader(stream, io.MultiWriter(hashWriter, counter))
+
+	uploadDir := path.Join(stagingDir, "upload")
+	filePaths, err := f.extractArchive(hashedStream, uploadDir, stagingDir)
+	if err == nil {
+		// archives can end before the stream does (tarballs are padded, for
+		// example), and the hash has to cover the whole thing
+		_, err = io.Copy(io.Discard, hashedStream)
+	}
+	if err != nil {
+		os.RemoveAll(stagingDir)
+		return stagedArchive{}, err
+	}
 
-	longestCommonPrefix := ""
+	root := uploadDir
 	if trimLeadingDirs {
+		longestCommonPrefix := utils.GetLongestCommonPrefix(filePaths)
+		// only whole directories can be trimmed, not parts of file names
+		lastSlash := strings.LastIndex(longestCommonPrefix, "/")
+		if lastSlash != -1 {
+			root = path.Join(uploadDir, longestCommonPrefix[0:lastSlash])
+		}
+	}
 
-		// perform a first pass over the contents of the tar data that just
-		// gathers the paths of the files in it and figures out if there's a
-		// common leading prefix that can be removed
+	return stagedArchive{
+		stagingDir: stagingDir,
+		root:       root,
+		hash:       hex.EncodeToString(hashWriter.Sum(nil)),
+		size:       counter.written,
+	}, nil
+}
+
+// renames sourceDir to outDir, unless outDir already exists, in which case it
+// must already contain the same files (since directories are named after
+// hashes) and is left as it is
+func moveIntoPlace(sourceDir string, outDir string) error {
+	if _, err := os.Stat(outDir); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(sourceDir, 0755); err != nil {
+		return err
+	}
+	if err := os.Chmod(sourceDir, 0755); err != nil {
+		return err
+	}
+	if err := os.Rename(sourceDir, outDir); err != nil {
+		return fmt.Errorf("could not move files into place: %w", err)
+	}
+	return nil
+}
 
-		var filePaths []string
+// moves the files in sourceDir into destDir, replacing any files that are
+// already there
+func moveTree(sourceDir string, destDir string) error {
+	return filepath.WalkDir(sourceDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		destPath := filepath.Join(destDir, relPath)
+		if d.IsDir() {
+			return os.MkdirAll(destPath, 0755)
+		}
+		// a deleted directory might have been replaced by a file or vice versa
+		if err := os.RemoveAll(destPath); err != nil {
+			return err
+		}
+		return os.Rename(p, destPath)
+	})
+}
 
-		for {
-			header, err := tarReader.Next()
+type countingWriter struct {
+	written int64
+}
 
-			if err == io.EOF {
-				break
-			}
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.written += int64(len(p))
+	return len(p), nil
+}
 
-			if header.Typeflag == tar.TypeReg {
-				filePaths = append(filePaths, header.Name)
-			}
+// recreates the files in sourceDir in destDir. the files are hard-linked
+// instead of copied where possible, which is safe since deployment files are
+// replaced instead of being written to (see extractArchive)
+func copyTree(sourceDir string, destDir string) error {
+	return filepath.WalkDir(sourceDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
 		}
+		relPath, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		destPath := filepath.Join(destDir, relPath)
+		if d.IsDir() {
+			return os.MkdirAll(destPath, 0755)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		return linkOrCopy(p, destPath)
+	})
+}
 
-		longestCommonPrefix = utils.GetLongestCommonPrefix(filePaths)
+// receives a stream of either a single executable (if entrypoint is empty) or an
+// archive that contains an executable at the path entrypoint, puts the
+// file(s) on disk, and returns the path of the executable
+func (f FileManager) ExecutableToDeploymentFiles(
+	stream io.Reader, contentName string, entrypoint string,
+) (string, error) {
+	deploymentDir := path.Join(f.config.DataDirectory, slug.Make(contentName))
 
-		lastSlash := strings.LastIndex(longestCommonPrefix, "/")
-		if lastSlash != -1 {
-			longestCommonPrefix = longestCommonPrefix[0 : lastSlash+1]
+	if len(entrypoint) == 0 {
+		stagingDir, err := newStagingDir(deploymentDir)
+		if err != nil {
+			return "", err
 		}
+		defer os.RemoveAll(stagingDir)
 
-		gzipStream.Seek(0, 0)
-		uncompressedStream.Reset(gzipStream)
-		tarReader = tar.NewReader(uncompressedStream)
+		outFile, err := os.OpenFile(path.Join(stagingDir, "app"), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+		if err != nil {
+			return "", fmt.Errorf("could not create executable: %w", err)
+		}
+		defer outFile.Close()
+		hashWriter := md5.New()
+		if _, err := io.Copy(io.MultiWriter(outFile, hashWriter), stream); err != nil {
+			return "", fmt.Errorf("could not write executable: %w", err)
+		}
+		if err := outFile.Close(); err != nil {
+			return "", fmt.Errorf("could not write executable: %w", err)
+		}
+
+		outDir := path.Join(deploymentDir, hex.EncodeToString(hashWriter.Sum(nil)))
+		if err := moveIntoPlace(stagingDir, outDir); err != nil {
+			return "", err
+		}
+		return path.Join(outDir, "app"), nil
+	}
+
+	if !filepath.IsLocal(entrypoint) {
+		return "", fmt.Errorf("entrypoint %s is not a local file path", entrypoint)
+	}
+	// the entrypoint is relative to the root of the archive, so leading
+	// directories have to be kept
+	staged, err := f.stageArchive(stream, deploymentDir, false)
+	if err != nil {
+		return "", err
 	}
+	defer os.RemoveAll(staged.stagingDir)
+	// tarballs don't always preserve the executable bit
+	if err := os.Chmod(path.Join(staged.root, entrypoint), 0755); err != nil {
+		return "", fmt.Errorf("could not find entrypoint %s: %w", entrypoint, err)
+	}
+	outDir := path.Join(deploymentDir, staged.hash)
+	if err := moveIntoPlace(staged.root, outDir); err != nil {
+		return "", err
+	}
+	return path.Join(outDir, entrypoint), nil
+}
 
-	for {
-		header, err := tarReader.Next()
+// an upload was rejected because it (probably) wasn't something that should be
+// extracted, like a zip bomb
+type ArchiveLimitError struct {
+	message string
+}
 
-		if err == io.EOF {
-			break
-		}
+func (e ArchiveLimitError) Error() string {
+	return e.message
+}
 
+// function that takes a stream containing an archive and extracts the files and
+// folders within to baseOutDir, returning the paths of the files that were
+// extracted. the archive's format is detected from its contents; anything that
+// mholt/archives can extract works, which includes .zip, .tar, .tar.gz,
+// .tar.zst, and .tar.xz.
+//
+// most formats are extracted straight from the stream as it's read, but zip
+// (and 7z) archives keep their index at the end, so those are saved to a file
+// in spoolDir first.
+//
+// the archive is rejected if any of its entries would end up outside of
+// baseOutDir, or if it has more entries or more (uncompressed) bytes than the
+// limits in the config allow
+func (f FileManager) extractArchive(stream io.Reader, baseOutDir string, spoolDir string) ([]string, error) {
+	ctx := context.Background()
+	os.MkdirAll(baseOutDir, 0750)
+
+	format, stream, err := archives.Identify(ctx, "", stream)
+	if err != nil {
+		return nil, fmt.Errorf("extractArchive: could not identify archive format: %w", err)
+	}
+	extractor, isExtractor := format.(archives.Extractor)
+	if !isExtractor {
+		return nil, fmt.Errorf("extractArchive: %s files are not archives", format.Extension())
+	}
+
+	switch format.(type) {
+	case archives.Zip, archives.SevenZip:
+		spoolFile, err := os.CreateTemp(spoolDir, "archive-")
 		if err != nil {
-			return fmt.Errorf("ExtractTarGz: Next() failed: %s", err.Error())
+			return nil, fmt.Errorf("extractArchive: could not save archive: %w", err)
 		}
-
-		if len(longestCommonPrefix) >= len(header.Name) {
-			fmt.Printf("skipping %s\n", header.Name)
-			continue
+		defer spoolFile.Close()
+		if _, err := io.Copy(spoolFile, stream); err != nil {
+			return nil, fmt.Errorf("extractArchive: could not save archive: %w", err)
 		}
+		if _, err := spoolFile.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		stream = spoolFile
+	}
 
-		itemName := strings.TrimPrefix(header.Name, longestCommonPrefix)
+	var filePaths []string
+	var extractedBytes int64
+	entries := 0
+	err = extractor.Extract(ctx, stream, func(ctx context.Context, entry archives.FileInfo) error {
+		entries++
+		if f.config.MaxArchiveEntries > 0 && entries > f.config.MaxArchiveEntries {
+			return ArchiveLimitError{fmt.Sprintf(
+				"archive has more than the maximum of %d entries", f.config.MaxArchiveEntries,
+			)}
+		}
+		if !filepath.IsLocal(entry.NameInArchive) {
+			return fmt.Errorf("extractArchive: File rejected: %s is not a local file path", entry.NameInArchive)
+		}
+		name := path.Clean(entry.NameInArchive)
+		outPath := path.Join(baseOutDir, name)
 
-		switch header.Typeflag {
-		case tar.TypeDir:
-			if err := os.MkdirAll(path.Join(baseOutDir, itemName), 0755); err != nil {
-				return fmt.Errorf("ExtractTarGz: MkdirAll() failed: %s", err.Error())
-			}
-		case tar.TypeReg:
-			if !filepath.IsLocal(header.Name) {
-				return fmt.Errorf("ExtractTarGz: File rejected: %s is not a local file path", header.Name)
-			}
-			outPath := path.Join(baseOutDir, itemName)
-			if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
-				return fmt.Errorf("ExtractTarGz: MkdirAll() failed: %s", err.Error())
-			}
-			outFile, err := os.Create(outPath)
-			if err != nil {
-				return fmt.Errorf("ExtractTarGz: Create() failed: %s", err.Error())
+		if entry.IsDir() {
+			if err := os.MkdirAll(outPath, 0755); err != nil {
+				return fmt.Errorf("extractArchive: MkdirAll() failed: %s", err.Error())
 			}
-			if _, err := io.Copy(outFile, tarReader); err != nil {
-				return fmt.Errorf("ExtractTarGz: Copy() failed: %s", err.Error())
-			}
-			outFile.Close()
+			return nil
+		}
+		if !entry.Mode().IsRegular() {
+			return fmt.Errorf("extractArchive: %s is not a regular file or directory", entry.NameInArchive)
+		}
+		filePaths = append(filePaths, name)
 
-		default:
-			return fmt.Errorf(
-				"ExtractTarGz: unknown type: %v in %v",
-				header.Typeflag,
-				header.Name)
+		if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+			return fmt.Errorf("extractArchive: MkdirAll() failed: %s", err.Error())
 		}
-	}
+		outFile, err := os.Create(outPath)
+		if err != nil {
+			return fmt.Errorf("extractArchive: Create() failed: %s", err.Error())
+		}
+		defer outFile.Close()
+		contents, err := entry.Open()
+		if err != nil {
+			return fmt.Errorf("extractArchive: Open() failed: %s", err.Error())
+		}
+		defer contents.Close()
 
-	return nil
+		// the size in the entry's header can't be trusted, so the limit is
+		// checked while the contents are actually being copied
+		var reader io.Reader = contents
+		if f.config.MaxExtractedSize > 0 {
+			reader = io.LimitReader(contents, f.config.MaxExtractedSize-extractedBytes+1)
+		}
+		written, err := io.Copy(outFile, reader)
+		extractedBytes += written
+		if err != nil {
+			return fmt.Errorf("extractArchive: Copy() failed: %s", err.Error())
+		}
+		if f.config.MaxExtractedSize > 0 && extractedBytes > f.config.MaxExtractedSize {
+			return ArchiveLimitError{fmt.Sprintf(
+				"archive contents are bigger than the maximum of %d bytes", f.config.MaxExtractedSize,
+			)}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filePaths, nil
 }
 
 func writeOutEmbeddedFs(files embed.FS, rootDir string, destDir string) error {
@@ -3,8 +3,10 @@ package utils
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"os"
 	"strings"
+	"time"
 )
 
 type Config struct {
@@ -12,6 +14,104 @@ type Config struct {
 	LocalOnly     bool
 	Verbose       bool
 	AdminApiPort  string
+	// how many of the most recent content revisions to keep for each
+	// deployment. zero or less means keep all of them
+	RevisionsToKeep int
+	// how often to look for (and delete) content directories that aren't used
+	// anymore. zero or less turns off the automatic garbage collection
+	GcInterval time.Duration
+	// how long a content directory has to be unused before it's deleted
+	GcGracePeriod time.Duration
+	// limits for uploaded archives, to guard against zip bombs. the size is
+	// the total size of the extracted files. zero or less means no limit
+	MaxExtractedSize  int64
+	MaxArchiveEntries int
+	// the biggest request body that can be used to upload files, in bytes.
+	// zero or less means no limit
+	MaxUploadSize int64
+	// path to an html file to show for requests that don't match any
+	// deployment. if this is empty, a short message is shown instead
+	DefaultPage string
+	// the ci systems and other oidc providers whose tokens are accepted as
+	// credentials
+	OidcIssuers []OidcIssuer
+	// how often each oidc issuer's signing keys are fetched again, and how
+	// much longer the old keys can keep being used if that fails
+	OidcKeysRefreshInterval time.Duration
+	OidcKeysFallback        time.Duration
+	// the path that the admin api is served at on every domain, which is
+	// where dashboard session cookies are sent
+	AdminApiPath string
+	// how long someone stays logged in to the admin dashboard
+	SessionLifetime time.Duration
+	// the client id of a github oauth app, which lets people log in to the
+	// admin dashboard with github. if this is empty, they can only log in
+	// with a token
+	GithubOAuthClientId string
+	// what requests from trusted sources (the trusted networks and the admin
+	// api's unix socket) can do without credentials
+	LocalTrust LocalTrustMode
+	// requests from these are trusted. this only makes sense for networks
+	// that nobody untrusted can send requests from
+	TrustedNetworks []netip.Prefix
+	// path to a unix socket that the admin api also listens on. requests that
+	// come in over it are trusted. if this is empty, there's no socket
+	AdminApiSocket string
+}
+
+type LocalTrustMode string
+
+const (
+	// requests from trusted sources can do anything
+	LocalTrustFull LocalTrustMode = "full"
+	// requests from trusted sources can create the first token, but they need
+	// credentials like any others once there is one
+	LocalTrustBootstrap LocalTrustMode = "bootstrap"
+	// no requests are trusted because of where they come from
+	LocalTrustOff LocalTrustMode = "off"
+)
+
+func ParseLocalTrustMode(mode string) (LocalTrustMode, error) {
+	switch LocalTrustMode(mode) {
+	case LocalTrustFull, LocalTrustBootstrap, LocalTrustOff:
+		return LocalTrustMode(mode), nil
+	}
+	return "", fmt.Errorf("unknown local trust mode %q; it has to be full, bootstrap, or off", mode)
+}
+
+// parses networks in cidr notation, like "10.0.0.0/8". single addresses are
+// also accepted
+func ParseTrustedNetworks(networks []string) ([]netip.Prefix, error) {
+	prefixes := []netip.Prefix{}
+	for _, network := range networks {
+		network = strings.TrimSpace(network)
+		if len(network) == 0 {
+			continue
+		}
+		if addr, err := netip.ParseAddr(network); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(network)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted network %q: %w", network, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+const DefaultMaxExtractedSize = 4 * 1024 * 1024 * 1024
+const DefaultMaxArchiveEntries = 100_000
+const DefaultMaxUploadSize = 1024 * 1024 * 1024
+const DefaultOidcKeysRefreshInterval = 10 * time.Minute
+const DefaultOidcKeysFallback = time.Hour
+const DefaultAdminApiPath = "/_golf"
+const DefaultSessionLifetime = 7 * 24 * time.Hour
+
+// just localhost
+var DefaultTrustedNetworks = []netip.Prefix{
+	netip.MustParsePrefix("127.0.0.1/32"), netip.MustParsePrefix("::1/128"),
 }
 
 // creates a new config object with the data that you pass in.
@@ -21,7 +121,10 @@ type Config struct {
 // the given path exists, it's immediately created.
 //
 // this should only need to be called once for every time the server is started.
-func NewConfig(dataDirectory string, localOnly bool, verbose bool, adminApiPort string) *Config {
+func NewConfig(
+	dataDirectory string, localOnly bool, verbose bool, adminApiPort string,
+	revisionsToKeep int, gcInterval time.Duration, gcGracePeriod time.Duration,
+) *Config {
 	dataDirectory, dataDirectoryError := setupDataDirectory(dataDirectory)
 	if dataDirectoryError != nil {
 		panic("Could not create data directory: " + dataDirectoryError.Error())
@@ -29,10 +132,27 @@ func NewConfig(dataDirectory string, localOnly bool, verbose bool, adminApiPort
 	fmt.Printf("Initialized data directory to %s\n", dataDirectory)
 
 	return &Config{
-		DataDirectory: dataDirectory,
-		LocalOnly:     localOnly,
-		Verbose:       verbose,
-		AdminApiPort:  adminApiPort,
+		DataDirectory:   dataDirectory,
+		LocalOnly:       localOnly,
+		Verbose:         verbose,
+		AdminApiPort:    adminApiPort,
+		RevisionsToKeep: revisionsToKeep,
+		GcInterval:      gcInterval,
+		GcGracePeriod:   gcGracePeriod,
+		// these can be changed after the config is created
+		MaxExtractedSize:  DefaultMaxExtractedSize,
+		MaxArchiveEntries: DefaultMaxArchiveEntries,
+		MaxUploadSize:     DefaultMaxUploadSize,
+		OidcIssuers:       []OidcIssuer{GithubOidcIssuer},
+
+		OidcKeysRefreshInterval: DefaultOidcKeysRefreshInterval,
+		OidcKeysFallback:        DefaultOidcKeysFallback,
+
+		AdminApiPath:    DefaultAdminApiPath,
+		SessionLifetime: DefaultSessionLifetime,
+
+		LocalTrust:      LocalTrustFull,
+		TrustedNetworks: DefaultTrustedNetworks,
 	}
 }
 
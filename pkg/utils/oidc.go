@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+)
+
+// an oidc provider, like a ci system, whose id tokens are accepted as
+// credentials. the claims in a token say which repo, ref, and user it was
+// issued for; which claims those are is different for each provider
+type OidcIssuer struct {
+	// the name that deployments and external users use as their
+	// ExternalSourceType, like "Github" or "Gitlab"
+	Name string `json:"name"`
+	// has to match the "iss" claim exactly
+	Issuer   string `json:"issuer"`
+	JwksUrl  string `json:"jwksUrl"`
+	Audience string `json:"audience"`
+	// the claim with the repo that the token was issued for, like "owner/repo"
+	RepoClaim string `json:"repoClaim"`
+	// the claim with the git ref, which can be either a full ref like
+	// "refs/heads/main" or just a branch name
+	RefClaim string `json:"refClaim"`
+	// optional; if this is set, the ref is only treated as a branch when this
+	// claim is "branch"
+	RefTypeClaim string `json:"refTypeClaim,omitempty"`
+	// the claims with the name and the stable id of the user who caused the
+	// token to be issued. the id is what external users are registered with
+	ActorClaim   string `json:"actorClaim"`
+	ActorIdClaim string `json:"actorIdClaim"`
+	// optional; the claim with the id of the ci run that the token was issued
+	// for, which goes in the audit log
+	RunIdClaim string `json:"runIdClaim,omitempty"`
+}
+
+// the issuer for github actions, which is always trusted unless it's replaced
+// by an issuer with the same name. example payload:
+//
+//	{
+//	  "actor": "internet-golf",
+//	  "actor_id": "49729978",
+//	  "aud": "https://github.com/internet-golf",
+//	  "base_ref": "",
+//	  "event_name": "workflow_dispatch",
+//	  "exp": 1756127261,
+//	  "head_ref": "",
+//	  "iat": 1756105661,
+//	  "iss": "https://token.actions.githubusercontent.com",
+//	  "job_workflow_ref": "internet-golf/internet-golf/.github/workflows/oidc-test.yml@refs/heads/main",
+//	  "job_workflow_sha": "54fd8fbf5de6050880e24d97dd9870942c04f258",
+//	  "jti": "975c1557-fd94-4a8b-ad76-89848e46cbfc",
+//	  "nbf": 1756105361,
+//	  "ref": "refs/heads/main",
+//	  "ref_protected": "false",
+//	  "ref_type": "branch",
+//	  "repository": "internet-golf/internet-golf",
+//	  "repository_id": "1034463833",
+//	  "repository_owner": "internet-golf",
+//	  "repository_owner_id": "49729978",
+//	  "repository_visibility": "public",
+//	  "run_attempt": "1",
+//	  "run_id": "17201782829",
+//	  "run_number": "2",
+//	  "runner_environment": "github-hosted",
+//	  "sha": "54fd8fbf5de6050880e24d97dd9870942c04f258",
+//	  "sub": "repo:internet-golf/internet-golf:ref:refs/heads/main",
+//	  "workflow": "Print and Post ID Token Variables",
+//	  "workflow_ref": "internet-golf/internet-golf/.github/workflows/oidc-test.yml@refs/heads/main",
+//	  "workflow_sha": "54fd8fbf5de6050880e24d97dd9870942c04f258"
+//	}
+var GithubOidcIssuer = OidcIssuer{
+	Name:         "Github",
+	Issuer:       "https://token.actions.githubusercontent.com",
+	JwksUrl:      "https://token.actions.githubusercontent.com/.well-known/jwks",
+	Audience:     "internet-golf",
+	RepoClaim:    "repository",
+	RefClaim:     "ref",
+	RefTypeClaim: "ref_type",
+	ActorClaim:   "actor",
+	ActorIdClaim: "actor_id",
+	RunIdClaim:   "run_id",
+}
+
+func (o OidcIssuer) validate() error {
+	if len(o.Name) == 0 {
+		return fmt.Errorf("oidc issuers need a name")
+	}
+	required := map[string]string{
+		"issuer": o.Issuer, "jwksUrl": o.JwksUrl, "audience": o.Audience, "repoClaim": o.RepoClaim,
+		"refClaim": o.RefClaim, "actorClaim": o.ActorClaim, "actorIdClaim": o.ActorIdClaim,
+	}
+	for field, value := range required {
+		if len(value) == 0 {
+			return fmt.Errorf("oidc issuer %s is missing %s", o.Name, field)
+		}
+	}
+	return nil
+}
+
+// reads a json file with a list of oidc issuers and returns them along with
+// the github issuer, unless the file has its own issuer named "Github"
+func LoadOidcIssuers(path string) ([]OidcIssuer, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read oidc issuers file: %w", err)
+	}
+	var issuers []OidcIssuer
+	if err := json.Unmarshal(contents, &issuers); err != nil {
+		return nil, fmt.Errorf("could not parse oidc issuers file: %w", err)
+	}
+	names := map[string]bool{}
+	for _, issuer := range issuers {
+		if err := issuer.validate(); err != nil {
+			return nil, err
+		}
+		if names[issuer.Name] {
+			return nil, fmt.Errorf("there's more than one oidc issuer named %s", issuer.Name)
+		}
+		names[issuer.Name] = true
+	}
+	if !slices.ContainsFunc(issuers, func(o OidcIssuer) bool { return o.Name == GithubOidcIssuer.Name }) {
+		issuers = append(issuers, GithubOidcIssuer)
+	}
+	return issuers, nil
+}
@@ -1,10 +1,18 @@
 package internetgolf_test
 
 import (
+	"errors"
+	"io"
+	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/internet-golf/internet-golf/pkg/api"
+	"github.com/internet-golf/internet-golf/pkg/db"
+	"github.com/internet-golf/internet-golf/pkg/resources"
 	"github.com/internet-golf/internet-golf/pkg/utils"
 )
 
@@ -27,6 +35,10 @@ func TestBasicBearerTokenFlow(t *testing.T) {
 		t.Fatal()
 	}
 	token := strings.Split(output, "\n")[1]
+	tokenId, _, _ := strings.Cut(token, ".")
+	if tokens := runClientCliCommand("tokens", port, t); !strings.Contains(tokens, tokenId) {
+		t.Fatalf("expected the token list to include %s, got %s", tokenId, tokens)
+	}
 
 	runClientCliCommand("create-deployment internet-golf-test.local", port, t)
 
@@ -46,3 +58,626 @@ func TestBasicBearerTokenFlow(t *testing.T) {
 		t.Fatalf("expected fnord, got %v", []byte(content))
 	}
 }
+
+func TestScopedBearerTokens(t *testing.T) {
+	config := utils.NewConfig(t.TempDir(), true, false, "0", 3, 0, time.Second)
+	database, err := db.NewDb(config, resources.NewFileManager(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	authManager := api.NewAuthManager(database, config)
+
+	token, err := authManager.CreateBearerToken(db.BearerToken{Scopes: []db.Scope{
+		{Urls: []string{"site.test"}, Actions: []db.Action{db.DeployAction}},
+		{UrlPrefixes: []string{"staging.test"}, Actions: []db.Action{db.ModifyAction, db.DeleteAction}},
+		{Tags: []string{"ci"}, Actions: []db.Action{db.ViewAction}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// from somewhere other than localhost, so that the token is what counts
+	permissions, err := authManager.GetPermissionsForRequest("203.0.113.7:4321", "Bearer "+token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deployment := func(url string, tags ...string) *db.Deployment {
+		domain, path, _ := strings.Cut(url, "/")
+		if len(path) > 0 {
+			path = "/" + path
+		}
+		return &db.Deployment{DeploymentMetadata: db.DeploymentMetadata{
+			Url: db.Url{Domain: domain, Path: path}, Tags: tags,
+		}}
+	}
+	site := deployment("site.test")
+	staging := deployment("staging.test/blog")
+	tagged := deployment("tagged.test", "ci")
+	other := deployment("other.test")
+	lookalike := deployment("staging.test.example.org")
+
+	checks := []struct {
+		description string
+		allowed     bool
+		expected    bool
+	}{
+		{"deploy to site", permissions.CanDeployToDeployment(site), true},
+		{"view site", permissions.CanViewDeployment(site), true},
+		{"modify site", permissions.CanModifyDeployment(site), false},
+		{"delete site", permissions.CanDeleteDeployment(site), false},
+		{"modify staging", permissions.CanModifyDeployment(staging), true},
+		{"delete staging", permissions.CanDeleteDeployment(staging), true},
+		{"deploy to staging", permissions.CanDeployToDeployment(staging), false},
+		{"create under staging", permissions.CanCreateDeployment(db.Url{Domain: "staging.test", Path: "/new"}), true},
+		{"create elsewhere", permissions.CanCreateDeployment(db.Url{Domain: "other.test"}), false},
+		{"view tagged", permissions.CanViewDeployment(tagged), true},
+		{"deploy to tagged", permissions.CanDeployToDeployment(tagged), false},
+		{"view other", permissions.CanViewDeployment(other), false},
+		{"delete lookalike", permissions.CanDeleteDeployment(lookalike), false},
+		{"create credentials", permissions.CanCreateCredentials(), false},
+		{"manage server", permissions.CanManageServer(), false},
+	}
+	for _, check := range checks {
+		if check.allowed != check.expected {
+			t.Errorf("expected %q to be %v", check.description, check.expected)
+		}
+	}
+}
+
+func TestBearerTokenLifecycle(t *testing.T) {
+	config := utils.NewConfig(t.TempDir(), true, false, "0", 3, 0, time.Second)
+	database, err := db.NewDb(config, resources.NewFileManager(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	authManager := api.NewAuthManager(database, config)
+
+	// from somewhere other than localhost, so that the token is what counts
+	tokenWorks := func(token string) (bool, error) {
+		_, err := authManager.GetPermissionsForRequest("203.0.113.7:4321", "Bearer "+token)
+		return err == nil, err
+	}
+
+	ciToken, err := authManager.CreateBearerToken(db.BearerToken{
+		Name: "ci", FullPermissions: true, ExpiresAt: time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiredToken, err := authManager.CreateBearerToken(db.BearerToken{
+		Name: "old", FullPermissions: true, ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciTokenId, _, _ := strings.Cut(ciToken, ".")
+
+	if works, err := tokenWorks(ciToken); !works {
+		t.Fatalf("expected the new token to work, got %v", err)
+	}
+	if works, err := tokenWorks(expiredToken); works || !errors.Is(err, api.ErrTokenExpired) {
+		t.Fatalf("expected the expired token to be rejected as expired, got %v", err)
+	}
+
+	tokens, err := authManager.GetBearerTokens()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 || tokens[0].Name != "ci" || tokens[1].Name != "old" {
+		t.Fatalf("expected the ci and old tokens in order of creation, got %+v", tokens)
+	}
+	if tokens[0].LastUsedAt.IsZero() {
+		t.Fatal("expected the ci token's use to be recorded")
+	}
+
+	rotatedToken, err := authManager.RotateBearerToken(ciTokenId, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotatedId, _, _ := strings.Cut(rotatedToken, "."); rotatedId != ciTokenId {
+		t.Fatalf("expected the rotated token to keep the id %s, got %s", ciTokenId, rotatedId)
+	}
+	if works, err := tokenWorks(ciToken); works || !errors.Is(err, api.ErrInvalidCredentials) {
+		t.Fatal("expected the token from before rotation to stop working")
+	}
+	if works, err := tokenWorks(rotatedToken); !works {
+		t.Fatalf("expected the rotated token to work, got %v", err)
+	}
+
+	if err := authManager.RevokeBearerToken(ciTokenId); err != nil {
+		t.Fatal(err)
+	}
+	if works, err := tokenWorks(rotatedToken); works || !errors.Is(err, api.ErrTokenRevoked) {
+		t.Fatalf("expected the revoked token to be rejected as revoked, got %v", err)
+	}
+	if _, err := authManager.RotateBearerToken(ciTokenId, time.Now().Add(time.Hour)); err == nil {
+		t.Fatal("expected revoked tokens to not be rotatable")
+	}
+}
+
+func TestAuthErrors(t *testing.T) {
+	config := utils.NewConfig(t.TempDir(), true, false, "0", 3, 0, time.Second)
+	database, err := db.NewDb(config, resources.NewFileManager(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	authManager := api.NewAuthManager(database, config)
+
+	token, err := authManager.CreateBearerToken(db.BearerToken{FullPermissions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenId, _, _ := strings.Cut(token, ".")
+
+	checks := []struct {
+		authHeader string
+		expected   error
+	}{
+		{"", api.ErrNoCredentials},
+		{"Basic Z29sZmVyOmhpCg==", api.ErrMalformedCredentials},
+		{"Bearer no-period", api.ErrMalformedCredentials},
+		{"Bearer nonexistent.secret", api.ErrInvalidCredentials},
+		{"Bearer " + tokenId + ".wrong", api.ErrInvalidCredentials},
+	}
+	for _, check := range checks {
+		_, err := authManager.GetPermissionsForRequest("198.51.100.1:1234", check.authHeader)
+		if !errors.Is(err, check.expected) {
+			t.Errorf("expected %q to give %v, got %v", check.authHeader, check.expected, err)
+		}
+	}
+
+	// after enough failures, even the right token is turned away, but only
+	// from the address that the failures came from
+	for range 10 {
+		authManager.GetPermissionsForRequest("198.51.100.2:1234", "Bearer nonexistent.secret")
+	}
+	_, err = authManager.GetPermissionsForRequest("198.51.100.2:5678", "Bearer "+token)
+	var authErr *api.AuthError
+	if !errors.As(err, &authErr) || !errors.Is(err, api.ErrTooManyFailures) || authErr.RetryAfter <= 0 {
+		t.Fatalf("expected a lockout with a retry time, got %v", err)
+	}
+	if _, err := authManager.GetPermissionsForRequest("198.51.100.3:1234", "Bearer "+token); err != nil {
+		t.Fatalf("expected other addresses to not be locked out, got %v", err)
+	}
+
+	// the errors should come out of the admin api as problem responses
+	portInt, portErr := utils.GetFreePort()
+	if portErr != nil {
+		t.Fatal(portErr)
+	}
+	port := strconv.Itoa(portInt)
+	stopServer := startFullServer(port)
+	defer stopServer()
+
+	for _, authHeader := range []string{"", "Bearer nonexistent.secret", "Bearer no-period"} {
+		req, err := http.NewRequest(http.MethodGet, "http://127.0.0.1:"+port+"/tokens", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// as if the request came through caddy from somewhere else
+		req.Header.Set("X-Forwarded-For", "198.51.100.4:1234")
+		if len(authHeader) > 0 {
+			req.Header.Set("Authorization", authHeader)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized || !strings.Contains(string(body), `"detail"`) {
+			t.Errorf("expected a 401 problem response for %q, got %d %s", authHeader, resp.StatusCode, body)
+		}
+	}
+}
+
+func TestDashboardSessions(t *testing.T) {
+	portInt, portErr := utils.GetFreePort()
+	if portErr != nil {
+		t.Fatal(portErr)
+	}
+	port := strconv.Itoa(portInt)
+	stopServer := startFullServer(port)
+	defer stopServer()
+
+	output := runClientCliCommand("create-token --name dashboard", port, t)
+	token := strings.Split(output, "\n")[1]
+	tokenId, _, _ := strings.Cut(token, ".")
+
+	// as if the requests came through caddy from a browser somewhere else
+	request := func(method string, path string, body string, headers map[string]string) (*http.Response, string) {
+		req, err := http.NewRequest(method, "http://127.0.0.1:"+port+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("X-Forwarded-For", "198.51.100.5:1234")
+		if len(body) > 0 {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp, string(respBody)
+	}
+
+	if resp, body := request(http.MethodPost, "/session/login", `{"token":"`+tokenId+`.wrong"}`, nil); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected a wrong token to not log in, got %d %s", resp.StatusCode, body)
+	}
+
+	sessionCookie := func(resp *http.Response) *http.Cookie {
+		for _, c := range resp.Cookies() {
+			if c.Name == api.SessionCookieName {
+				return c
+			}
+		}
+		return nil
+	}
+
+	resp, body := request(http.MethodPost, "/session/login", `{"token":"`+token+`"}`, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected to log in, got %d %s", resp.StatusCode, body)
+	}
+	cookie := sessionCookie(resp)
+	if cookie == nil || !cookie.HttpOnly || !cookie.Secure || cookie.Path != utils.DefaultAdminApiPath ||
+		cookie.SameSite != http.SameSiteStrictMode {
+		t.Fatalf("expected an http-only secure session cookie for the admin api, got %+v", cookie)
+	}
+	csrfToken := api.CsrfTokenForSession(cookie.Value)
+	if !strings.Contains(body, csrfToken) || !strings.Contains(body, tokenId) {
+		t.Fatalf("expected the login response to have the csrf token and identity, got %s", body)
+	}
+	cookieHeader := map[string]string{"Cookie": api.SessionCookieName + "=" + cookie.Value}
+	withCsrf := map[string]string{"Cookie": cookieHeader["Cookie"], api.CsrfHeaderName: csrfToken}
+
+	if resp, body := request(http.MethodGet, "/tokens", "", cookieHeader); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the session to be able to list tokens, got %d %s", resp.StatusCode, body)
+	}
+	if resp, body := request(http.MethodGet, "/session", "", cookieHeader); !strings.Contains(body, csrfToken) {
+		t.Fatalf("expected the session endpoint to give the csrf token, got %d %s", resp.StatusCode, body)
+	}
+	newToken := `{"name":"from the dash","fullPermissions":true}`
+	if resp, body := request(http.MethodPost, "/token/generate", newToken, cookieHeader); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected a request without the csrf token to be rejected, got %d %s", resp.StatusCode, body)
+	}
+	if resp, body := request(http.MethodPost, "/token/generate", newToken, withCsrf); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a request with the csrf token to work, got %d %s", resp.StatusCode, body)
+	}
+
+	if resp, body := request(http.MethodPost, "/session/logout", "", withCsrf); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected to log out, got %d %s", resp.StatusCode, body)
+	}
+	if resp, _ := request(http.MethodGet, "/tokens", "", cookieHeader); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the session to stop working after logging out, got %d", resp.StatusCode)
+	}
+
+	// sessions only last as long as the token that they were started with, so
+	// rotating the token ends them too
+	resp, _ = request(http.MethodPost, "/session/login", `{"token":"`+token+`"}`, nil)
+	cookieHeader = map[string]string{"Cookie": api.SessionCookieName + "=" + sessionCookie(resp).Value}
+	output = runClientCliCommand("rotate-token "+tokenId, port, t)
+	if resp, _ := request(http.MethodGet, "/tokens", "", cookieHeader); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the session to stop working after its token was rotated, got %d", resp.StatusCode)
+	}
+
+	token = strings.Split(output, "\n")[1]
+	resp, body = request(http.MethodPost, "/session/login", `{"token":"`+token+`"}`, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected to log in with the rotated token, got %d %s", resp.StatusCode, body)
+	}
+	cookieHeader = map[string]string{"Cookie": api.SessionCookieName + "=" + sessionCookie(resp).Value}
+	if resp, body := request(http.MethodGet, "/tokens", "", cookieHeader); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a session with the rotated token to work, got %d %s", resp.StatusCode, body)
+	}
+	runClientCliCommand("revoke-token "+tokenId, port, t)
+	if resp, _ := request(http.MethodGet, "/tokens", "", cookieHeader); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the session to stop working after its token was revoked, got %d", resp.StatusCode)
+	}
+}
+
+func TestLocalTrust(t *testing.T) {
+	config := utils.NewConfig(t.TempDir(), true, false, "0", 3, 0, time.Second)
+	database, err := db.NewDb(config, resources.NewFileManager(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	authManager := api.NewAuthManager(database, config)
+
+	config.TrustedNetworks, err = utils.ParseTrustedNetworks([]string{"::1", "10.1.0.0/16"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for remoteAddr, trusted := range map[string]bool{
+		"[::1]:1234":     true,
+		"10.1.2.3:1234":  true,
+		"127.0.0.1:1234": false,
+		"10.2.0.1:1234":  false,
+	} {
+		permissions, err := authManager.GetPermissionsForRequest(remoteAddr, "")
+		if trusted && (err != nil || !permissions.CanManageServer()) {
+			t.Errorf("expected %s to be trusted, got %v", remoteAddr, err)
+		} else if !trusted && !errors.Is(err, api.ErrNoCredentials) {
+			t.Errorf("expected %s to need credentials, got %v", remoteAddr, err)
+		}
+	}
+
+	config.LocalTrust = utils.LocalTrustOff
+	if _, err := authManager.GetPermissionsForRequest("[::1]:1234", ""); !errors.Is(err, api.ErrNoCredentials) {
+		t.Fatalf("expected nothing to be trusted when local trust is off, got %v", err)
+	}
+
+	// in bootstrap mode, local requests can only create the first token
+	config.LocalTrust = utils.LocalTrustBootstrap
+	permissions, err := authManager.GetPermissionsForRequest("[::1]:1234", "")
+	if err != nil || !permissions.CanBootstrapToken() || permissions.CanCreateCredentials() ||
+		permissions.CanManageServer() || permissions.CanCreateDeployment(db.Url{Domain: "example.com"}) {
+		t.Fatalf("expected bootstrap permissions to only allow creating the first token, got %v", err)
+	}
+	token, err := authManager.CreateFirstBearerToken(db.BearerToken{FullPermissions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := authManager.CreateFirstBearerToken(db.BearerToken{FullPermissions: true}); !errors.Is(err, db.ErrBearerTokensExist) {
+		t.Fatalf("expected only one first token to be allowed, got %v", err)
+	}
+	if _, err := authManager.GetPermissionsForRequest("[::1]:1234", ""); !errors.Is(err, api.ErrNoCredentials) {
+		t.Fatalf("expected local requests to need credentials once there's a token, got %v", err)
+	}
+	if permissions, err := authManager.GetPermissionsForRequest("[::1]:1234", "Bearer "+token); err != nil ||
+		!permissions.CanManageServer() {
+		t.Fatalf("expected the token to work from a local address, got %v", err)
+	}
+
+	// requests over the admin api socket are trusted even when no networks are
+	portInt, portErr := utils.GetFreePort()
+	if portErr != nil {
+		t.Fatal(portErr)
+	}
+	port := strconv.Itoa(portInt)
+	socketPath := t.TempDir() + "/admin.sock"
+	stopServer := startFullServerWithConfig(port, func(c *utils.Config) {
+		c.TrustedNetworks = nil
+		c.AdminApiSocket = socketPath
+	})
+	defer stopServer()
+
+	if output := runClientCliCommand("--api-url unix://"+socketPath+" create-token", "", t); !strings.Contains(output, "Generated token:") {
+		t.Fatalf("expected to be able to create a token over the socket, got %s", output)
+	}
+	resp, err := http.Get("http://127.0.0.1:" + port + "/tokens")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected localhost to not be trusted without trusted networks, got %d", resp.StatusCode)
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	portInt, portErr := utils.GetFreePort()
+	if portErr != nil {
+		t.Fatal(portErr)
+	}
+	port := strconv.Itoa(portInt)
+	// so that everything after the first token is done with the token
+	stopServer := startFullServerWithConfig(port, func(c *utils.Config) {
+		c.LocalTrust = utils.LocalTrustBootstrap
+	})
+	defer stopServer()
+
+	output := runClientCliCommand("create-token --name audit", port, t)
+	token := strings.Split(output, "\n")[1]
+	tokenId, _, _ := strings.Cut(token, ".")
+
+	runClientCliCommand("create-deployment audit-test.local --auth "+token, port, t)
+	runClientCliCommand("deploy-content audit-test.local --files ./fixtures/static-site --auth "+token, port, t)
+	revisions := runClientCliCommand("revisions audit-test.local --auth "+token, port, t)
+	contentHash := strings.Fields(revisions)[0]
+
+	req, err := http.NewRequest(http.MethodDelete, "http://127.0.0.1:"+port+"/deployment/nothing-here.local", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected deleting a nonexistent deployment to fail, got %d", resp.StatusCode)
+	}
+
+	log := runClientCliCommand("audit --auth "+token, port, t)
+	entryFor := func(action string) string {
+		for _, line := range strings.Split(log, "\n") {
+			if strings.Contains(line, "  "+action+"  ") {
+				return line
+			}
+		}
+		t.Fatalf("expected an entry for %s, got %s", action, log)
+		return ""
+	}
+	if entry := entryFor("post-token-generate"); !strings.Contains(entry, "local (bootstrapping)") ||
+		!strings.Contains(entry, "token "+tokenId) {
+		t.Errorf("expected the token creation to be logged with the new token, got %q", entry)
+	}
+	if entry := entryFor("DeployManifest"); !strings.Contains(entry, "token "+tokenId) ||
+		!strings.Contains(entry, "audit-test.local") || !strings.Contains(entry, contentHash) ||
+		!strings.HasSuffix(entry, "success (200)") {
+		t.Errorf("expected the deploy to be logged with its content hash %s, got %q", contentHash, entry)
+	}
+	if entry := entryFor("DeleteDeployment"); !strings.Contains(entry, "nothing-here.local") ||
+		!strings.HasSuffix(entry, "failed (404)") {
+		t.Errorf("expected the failed delete to be logged, got %q", entry)
+	}
+	if strings.Contains(log, "GetRevisions") || strings.Contains(log, "CheckManifest") {
+		t.Errorf("expected requests that only read things to not be logged, got %s", log)
+	}
+
+	filtered := runClientCliCommand("audit --target audit-test.local --outcome success --auth "+token, port, t)
+	if !strings.Contains(filtered, "DeployManifest") || strings.Contains(filtered, "DeleteDeployment") ||
+		strings.Contains(filtered, "post-token-generate") {
+		t.Errorf("expected only the entries for the deployment, got %s", filtered)
+	}
+	if recent := runClientCliCommand("audit --since 1h --limit 1 --auth "+token, port, t); !strings.Contains(recent, "DeleteDeployment") ||
+		strings.Contains(recent, "DeployManifest") {
+		t.Errorf("expected just the newest entry, got %s", recent)
+	}
+}
+
+func TestProxyDeploymentsNeedServerAccess(t *testing.T) {
+	portInt, portErr := utils.GetFreePort()
+	if portErr != nil {
+		t.Fatal(portErr)
+	}
+	port := strconv.Itoa(portInt)
+	stopServer := startFullServer(port)
+	defer stopServer()
+
+	runClientCliCommand("create-deployment "+BasicTestHost, port, t)
+	output := runClientCliCommand(
+		"create-token --url "+BasicTestHost+" --actions view,deploy,modify,delete", port, t,
+	)
+	token := strings.Split(output, "\n")[1]
+
+	// pointing the site at the admin api, and making every request to it look
+	// like it came from localhost, would hand out full permissions to anyone
+	body := `{"url":"` + BasicTestHost + `","upstreams":["127.0.0.1:` + port + `"],` +
+		`"headers":{"X-Forwarded-For":"127.0.0.1"}}`
+	req, err := http.NewRequest(http.MethodPut, "http://127.0.0.1:"+port+"/deploy/proxy", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-Forwarded-For", "198.51.100.6:1234")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	respBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected a token for one site to not be able to create a proxy, got %d %s", resp.StatusCode, respBody)
+	}
+	if !strings.Contains(string(respBody), "proxy to other servers") {
+		t.Fatalf("expected the proxy to be refused for needing server access, got %s", respBody)
+	}
+}
+
+func TestShareTokensOnlyShownToEditors(t *testing.T) {
+	portInt, portErr := utils.GetFreePort()
+	if portErr != nil {
+		t.Fatal(portErr)
+	}
+	port := strconv.Itoa(portInt)
+	stopServer := startFullServer(port)
+	defer stopServer()
+
+	shareToken := "abcdefghijklmnop1234"
+	runClientCliCommand("create-deployment "+BasicTestHost+" --share-token "+shareToken, port, t)
+
+	getDeployment := func(actions string) string {
+		output := runClientCliCommand("create-token --url "+BasicTestHost+" --actions "+actions, port, t)
+		token := strings.Split(output, "\n")[1]
+		req, err := http.NewRequest(http.MethodGet, "http://127.0.0.1:"+port+"/deployment/"+BasicTestHost, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		// from somewhere other than localhost, so that the token is what counts
+		req.Header.Set("X-Forwarded-For", "198.51.100.6:1234")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected to be able to get the deployment, got %d %s", resp.StatusCode, body)
+		}
+		return string(body)
+	}
+
+	// anyone who can see the deployment can find out that it has a share
+	// token, but only someone who could change it gets to see it
+	viewed := getDeployment("view")
+	if strings.Contains(viewed, shareToken) || !strings.Contains(viewed, `"shareTokenSet":true`) {
+		t.Fatalf("expected the share token to be hidden from a view-only token, got %s", viewed)
+	}
+	modified := getDeployment("view,modify")
+	if !strings.Contains(modified, shareToken) {
+		t.Fatalf("expected the share token to be shown to a token that can modify the deployment, got %s", modified)
+	}
+}
+
+func TestBootstrapOnlyCreatesOneToken(t *testing.T) {
+	portInt, portErr := utils.GetFreePort()
+	if portErr != nil {
+		t.Fatal(portErr)
+	}
+	port := strconv.Itoa(portInt)
+	stopServer := startFullServerWithConfig(port, func(c *utils.Config) {
+		c.LocalTrust = utils.LocalTrustBootstrap
+	})
+	defer stopServer()
+
+	request := func(method string, path string, body string) (int, string) {
+		req, err := http.NewRequest(method, "http://127.0.0.1:"+port+path, strings.NewReader(body))
+		if err != nil {
+			t.Error(err)
+			return 0, ""
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Error(err)
+			return 0, ""
+		}
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(respBody)
+	}
+
+	// creating the first token is the only thing that bootstrapping allows
+	for _, forbidden := range []struct{ method, path, body string }{
+		{http.MethodGet, "/tokens", ""},
+		{http.MethodPut, "/user/register", `{"externalUserId":"1","externalUserSource":"Github"}`},
+		{http.MethodDelete, "/token/nothing", ""},
+		{http.MethodPost, "/token/nothing/rotate", `{}`},
+	} {
+		if status, body := request(forbidden.method, forbidden.path, forbidden.body); status != http.StatusForbidden {
+			t.Errorf("expected %s %s to be forbidden while bootstrapping, got %d %s", forbidden.method, forbidden.path, status, body)
+		}
+	}
+
+	// even if the requests come in at the same time
+	var created sync.WaitGroup
+	statuses := make([]int, 4)
+	for i := range statuses {
+		created.Add(1)
+		go func() {
+			defer created.Done()
+			statuses[i], _ = request(http.MethodPost, "/token/generate", `{"fullPermissions":true}`)
+		}()
+	}
+	created.Wait()
+	successes := 0
+	for _, status := range statuses {
+		if status == http.StatusOK {
+			successes++
+		} else if status != http.StatusForbidden && status != http.StatusUnauthorized {
+			t.Errorf("expected extra tokens to be refused, got %d", status)
+		}
+	}
+	if successes != 1 {
+		t.Fatalf("expected exactly one token to be created, got statuses %v", statuses)
+	}
+}
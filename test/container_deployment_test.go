@@ -0,0 +1,412 @@
+// tests for docker container deployments. these run against a fake docker
+// engine that speaks just enough of the engine api for the ContainerManager;
+// "running" a container in it means starting a tiny http server on the host
+// port that the container was created with.
+
+package internetgolf_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/internet-golf/internet-golf/pkg/db"
+)
+
+type fakeContainer struct {
+	id       string
+	name     string
+	image    string
+	labels   map[string]string
+	hostPort string
+	server   *http.Server
+	// closed when the container stops running
+	exited chan struct{}
+}
+
+type fakeDockerEngine struct {
+	mutex      sync.Mutex
+	containers map[string]*fakeContainer
+	nextId     int
+}
+
+var apiVersionPrefix = regexp.MustCompile(`^/v[0-9.]+`)
+
+// starts a fake docker engine listening on a unix socket and points the
+// DOCKER_HOST environment variable at it for the rest of the test
+func startFakeDockerEngine(t *testing.T) *fakeDockerEngine {
+	socketDir, err := os.MkdirTemp("", "golf-docker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempDirs = append(tempDirs, socketDir)
+	socketPath := path.Join(socketDir, "docker.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	engine := &fakeDockerEngine{containers: map[string]*fakeContainer{}}
+	server := &http.Server{Handler: engine}
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+
+	t.Setenv("DOCKER_HOST", "unix://"+socketPath)
+	return engine
+}
+
+func (e *fakeDockerEngine) findContainer(idOrName string) *fakeContainer {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	for _, c := range e.containers {
+		if c.id == idOrName || c.name == idOrName {
+			return c
+		}
+	}
+	return nil
+}
+
+func (e *fakeDockerEngine) findContainerByAddress(address string) *fakeContainer {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	for _, c := range e.containers {
+		if "127.0.0.1:"+c.hostPort == address {
+			return c
+		}
+	}
+	return nil
+}
+
+func (e *fakeDockerEngine) containerCount() int {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return len(e.containers)
+}
+
+func (c *fakeContainer) start() error {
+	if c.server != nil {
+		return nil
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:"+c.hostPort)
+	if err != nil {
+		return err
+	}
+	c.exited = make(chan struct{})
+	c.server = &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "hello from %s", c.image)
+	})}
+	go c.server.Serve(listener)
+	return nil
+}
+
+// this is used both for stopping the container on purpose and for simulating
+// a crash
+func (c *fakeContainer) stop() {
+	if c.server == nil {
+		return
+	}
+	c.server.Close()
+	c.server = nil
+	close(c.exited)
+}
+
+func writeDockerError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
+
+func (e *fakeDockerEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	urlPath := apiVersionPrefix.ReplaceAllString(r.URL.Path, "")
+	w.Header().Set("Api-Version", "1.47")
+
+	switch {
+	case urlPath == "/_ping":
+		w.Write([]byte("OK"))
+
+	case urlPath == "/images/create" && r.Method == http.MethodPost:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"status": "Downloaded newer image"})
+
+	case urlPath == "/images/load" && r.Method == http.MethodPost:
+		io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"stream": "Loaded image\n"})
+
+	case strings.HasPrefix(urlPath, "/images/") && strings.HasSuffix(urlPath, "/json"):
+		writeDockerError(w, http.StatusNotFound, "No such image")
+
+	case urlPath == "/containers/json" && r.Method == http.MethodGet:
+		// only label filters are supported, since those are all that the
+		// ContainerManager uses
+		var filters struct{ Label map[string]bool }
+		if encoded := r.URL.Query().Get("filters"); encoded != "" {
+			if err := json.Unmarshal([]byte(encoded), &filters); err != nil {
+				writeDockerError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		found := []map[string]any{}
+		e.mutex.Lock()
+		for _, c := range e.containers {
+			matches := true
+			for label := range filters.Label {
+				key, value, _ := strings.Cut(label, "=")
+				if c.labels[key] != value {
+					matches = false
+				}
+			}
+			if matches {
+				found = append(found, map[string]any{
+					"Id": c.id, "Names": []string{"/" + c.name}, "Image": c.image, "Labels": c.labels,
+				})
+			}
+		}
+		e.mutex.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(found)
+
+	case urlPath == "/containers/create" && r.Method == http.MethodPost:
+		var body struct {
+			Image      string
+			Labels     map[string]string
+			HostConfig struct {
+				PortBindings map[string][]struct{ HostIp, HostPort string }
+			}
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeDockerError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if strings.HasPrefix(body.Image, "golf-broken-app") {
+			writeDockerError(w, http.StatusInternalServerError, "this image is broken")
+			return
+		}
+		var hostPort string
+		for _, bindings := range body.HostConfig.PortBindings {
+			hostPort = bindings[0].HostPort
+		}
+
+		e.mutex.Lock()
+		e.nextId++
+		c := &fakeContainer{
+			id:       fmt.Sprintf("container%d", e.nextId),
+			name:     r.URL.Query().Get("name"),
+			image:    body.Image,
+			labels:   body.Labels,
+			hostPort: hostPort,
+		}
+		e.containers[c.id] = c
+		e.mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{"Id": c.id, "Warnings": []string{}})
+
+	case strings.HasPrefix(urlPath, "/containers/"):
+		parts := strings.Split(strings.TrimPrefix(urlPath, "/containers/"), "/")
+		c := e.findContainer(parts[0])
+		if c == nil {
+			writeDockerError(w, http.StatusNotFound, "No such container: "+parts[0])
+			return
+		}
+
+		action := ""
+		if len(parts) > 1 {
+			action = parts[1]
+		}
+
+		switch {
+		case action == "start":
+			e.mutex.Lock()
+			err := c.start()
+			e.mutex.Unlock()
+			if err != nil {
+				writeDockerError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+		case action == "stop":
+			e.mutex.Lock()
+			c.stop()
+			e.mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+
+		case action == "wait":
+			e.mutex.Lock()
+			exited := c.exited
+			e.mutex.Unlock()
+			// the client waits for the headers before it starts waiting for
+			// the result
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			if exited != nil {
+				select {
+				case <-exited:
+				case <-r.Context().Done():
+					return
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]any{"StatusCode": 1})
+
+		case action == "" && r.Method == http.MethodDelete:
+			e.mutex.Lock()
+			c.stop()
+			delete(e.containers, c.id)
+			e.mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			writeDockerError(w, http.StatusNotFound, "not implemented by fake engine")
+		}
+
+	default:
+		writeDockerError(w, http.StatusNotFound, "not implemented by fake engine")
+	}
+}
+
+// keeps requesting the url until it returns the expected content or the
+// timeout is reached
+func waitForPageContent(url string, expected string, timeout time.Duration, t *testing.T) {
+	deadline := time.Now().Add(timeout)
+	for {
+		content := urlToPageContent(url, t)
+		if content == expected {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %q at %s, got %q", expected, url, content)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestContainerDeployment(t *testing.T) {
+	engine := startFakeDockerEngine(t)
+
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	url := "http://" + BasicTestHost
+	assertUrlEmpty(url, t)
+
+	deploymentUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: deploymentUrl}); err != nil {
+		t.Fatal(err)
+	}
+	deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := deploymentBus.PutContainerForDeployment(
+		deployment, "golf-test-app:latest", nil, 8080,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "hello from golf-test-app:latest"
+	waitForPageContent(url, expected, 5*time.Second, t)
+
+	// simulate a crash; the container manager should notice and start the
+	// container back up
+	deployment, err = deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	container := engine.findContainerByAddress(deployment.ServedThing)
+	if container == nil {
+		t.Fatal("could not find container in fake docker engine")
+	}
+	engine.mutex.Lock()
+	container.stop()
+	engine.mutex.Unlock()
+
+	waitForPageContent(url, expected, 5*time.Second, t)
+
+	// redeploying replaces the old container once the new one is in use
+	if err := deploymentBus.PutContainerForDeployment(
+		deployment, "golf-test-app:v2", nil, 8080,
+	); err != nil {
+		t.Fatal(err)
+	}
+	expected = "hello from golf-test-app:v2"
+	waitForPageContent(url, expected, 5*time.Second, t)
+	if count := engine.containerCount(); count != 1 {
+		t.Fatalf("expected the old container to be removed, but fake engine has %d containers", count)
+	}
+
+	// if the new container can't be started, the old one keeps serving the
+	// site
+	if err := deploymentBus.PutContainerForDeployment(
+		deployment, "golf-broken-app:latest", nil, 8080,
+	); err == nil {
+		t.Fatal("expected broken image to fail to deploy")
+	}
+	if content := urlToPageContent(url, t); content != expected {
+		t.Fatalf("expected %q after failed redeploy, got %q", expected, content)
+	}
+
+	// deleting the deployment should get rid of the container
+	if err := deploymentBus.DeleteDeployment(deploymentUrl); err != nil {
+		t.Fatal(err)
+	}
+	if count := engine.containerCount(); count != 0 {
+		t.Fatalf("expected container to be removed, but fake engine has %d containers", count)
+	}
+}
+
+func TestContainerNamesAreUnique(t *testing.T) {
+	engine := startFakeDockerEngine(t)
+
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	// these have the same slug
+	urls := []db.Url{
+		{Domain: BasicTestHost, Path: "/bar"},
+		{Domain: BasicTestHost + "-bar"},
+	}
+	for _, deploymentUrl := range urls {
+		if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: deploymentUrl}); err != nil {
+			t.Fatal(err)
+		}
+		deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := deploymentBus.PutContainerForDeployment(
+			deployment, "golf-test-app:latest", nil, 8080,
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names := map[string]bool{}
+	for _, deploymentUrl := range urls {
+		deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		container := engine.findContainerByAddress(deployment.ServedThing)
+		if container == nil {
+			t.Fatalf("could not find container for %s", deploymentUrl.String())
+		}
+		names[container.name] = true
+	}
+	if len(names) != len(urls) || engine.containerCount() != len(urls) {
+		t.Fatalf("expected %d separate containers, got names %v", len(urls), names)
+	}
+}
@@ -5,21 +5,36 @@
 package internetgolf_test
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/internet-golf/internet-golf/pkg/api"
 	"github.com/internet-golf/internet-golf/pkg/db"
 	"github.com/internet-golf/internet-golf/pkg/public"
 	"github.com/internet-golf/internet-golf/pkg/resources"
 	"github.com/internet-golf/internet-golf/pkg/utils"
+	"github.com/mholt/archives"
+	"golang.org/x/crypto/bcrypt"
 )
 
 var tempDirs []string
 
 func createBus() *api.DeploymentBus {
+	return createBusWithConfig(nil)
+}
+
+// like createBus, but lets the test change the config before the bus is created
+func createBusWithConfig(configure func(*utils.Config)) *api.DeploymentBus {
 
 	tempDir, tempDirError := os.MkdirTemp("", "internet-golf-test")
 	if tempDirError != nil {
@@ -27,12 +42,22 @@ func createBus() *api.DeploymentBus {
 	}
 	tempDirs = append(tempDirs, tempDir)
 
+	deploymentBus, _ := openBus(tempDir, configure)
+	return deploymentBus
+}
+
+// creates a bus (and its database) that keeps its data in dataDir, which
+// might have data from an earlier bus in it
+func openBus(dataDir string, configure func(*utils.Config)) (*api.DeploymentBus, db.Db) {
 	// the port doesn't matter since we're not actually starting the admin api
-	config := utils.NewConfig(tempDir, true, false, "0")
+	config := utils.NewConfig(dataDir, true, false, "0", 3, 0, time.Second)
+	if configure != nil {
+		configure(config)
+	}
 
 	fileManager := resources.NewFileManager(config)
 
-	db, err := db.NewDb(config, fileManager)
+	database, err := db.NewDb(config, fileManager)
 	if err != nil {
 		panic(err)
 	}
@@ -42,12 +67,21 @@ func createBus() *api.DeploymentBus {
 		panic(err)
 	}
 
-	deploymentBus, err := api.NewDeploymentBus(deploymentServer, db, fileManager)
+	// this uses the DOCKER_HOST environment variable, which tests can point
+	// at a fake docker engine
+	containerManager, err := resources.NewContainerManager("")
 	if err != nil {
 		panic(err)
 	}
 
-	return deploymentBus
+	deploymentBus, err := api.NewDeploymentBus(
+		config, deploymentServer, database, fileManager, containerManager, resources.NewProcessManager(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return deploymentBus, database
 }
 
 // this is called by TestMain which lives in utils_test.go
@@ -254,3 +288,1262 @@ func TestAliasDeployment(t *testing.T) {
 	}
 
 }
+
+func TestReverseProxyDeployment(t *testing.T) {
+
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	url := "http://" + BasicTestHost
+	assertUrlEmpty(url, t)
+
+	// start two upstream servers that say who they are and echo back a header
+	// that the proxy is supposed to set
+	upstreams := []*httptest.Server{}
+	for _, name := range []string{"a", "b"} {
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "%s %s", name, r.Header.Get("X-Golf-Test"))
+		}))
+		defer upstream.Close()
+		upstreams = append(upstreams, upstream)
+	}
+
+	deploymentUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: deploymentUrl}); err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PutReverseProxyDeployment(deploymentUrl, db.DeploymentContent{
+		ProxyUpstreams: []string{
+			upstreams[0].Listener.Addr().String(), upstreams[1].Listener.Addr().String(),
+		},
+		ProxyLoadBalancing:       "round_robin",
+		ProxyHealthCheckPath:     "/",
+		ProxyHealthCheckInterval: "100ms",
+		ProxyHeaders:             map[string]string{"X-Golf-Test": "proxied"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// with round robin load balancing, both upstreams should be hit
+	seen := map[string]bool{}
+	for range 4 {
+		seen[urlToPageContent(url, t)] = true
+	}
+	if !seen["a proxied"] || !seen["b proxied"] {
+		t.Fatalf("expected responses from both upstreams, got %v", seen)
+	}
+
+	// once an upstream goes down, the health checks should take it out of
+	// rotation
+	upstreams[1].Close()
+	time.Sleep(500 * time.Millisecond)
+	for range 4 {
+		if bodyStr := urlToPageContent(url, t); bodyStr != "a proxied" {
+			t.Fatalf("expected only the healthy upstream to be used, got %q", bodyStr)
+		}
+	}
+
+	if err := deploymentBus.PutReverseProxyDeployment(deploymentUrl, db.DeploymentContent{
+		ProxyUpstreams: []string{"not a host and port"},
+	}); err == nil {
+		t.Fatal("expected invalid upstream to be rejected")
+	}
+
+	for _, header := range []string{"Host", "x-forwarded-for", "X-Forwarded-Proto"} {
+		if err := deploymentBus.PutReverseProxyDeployment(deploymentUrl, db.DeploymentContent{
+			ProxyUpstreams: []string{upstreams[0].Listener.Addr().String()},
+			ProxyHeaders:   map[string]string{header: "127.0.0.1"},
+		}); err == nil {
+			t.Fatalf("expected overriding %s to be rejected", header)
+		}
+	}
+}
+
+func TestRevisionsAndRollback(t *testing.T) {
+
+	// note that createBus configures the bus to keep 3 revisions
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	url := "http://" + BasicTestHost
+	assertUrlEmpty(url, t)
+
+	deploymentUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: deploymentUrl}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i <= 4; i++ {
+		deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := deploymentBus.PutStaticFilesForDeployment(
+			deployment,
+			tarGzFromFiles(map[string]string{"index.html": fmt.Sprintf("version %d", i)}, t),
+			false, "tester",
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if bodyStr := urlToPageContent(url, t); bodyStr != "version 4" {
+		t.Fatalf("expected version 4, got %q", bodyStr)
+	}
+
+	deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deployment.Revisions) != 3 {
+		t.Fatalf("expected 3 revisions to be kept, got %d", len(deployment.Revisions))
+	}
+	if deployment.Revisions[2].UploadedBy != "tester" || deployment.Revisions[2].Size == 0 {
+		t.Fatalf("revision metadata was not recorded: %+v", deployment.Revisions[2])
+	}
+
+	// with no revision specified, this goes back to the previous one
+	if _, err := deploymentBus.RollbackDeployment(deploymentUrl, ""); err != nil {
+		t.Fatal(err)
+	}
+	if bodyStr := urlToPageContent(url, t); bodyStr != "version 3" {
+		t.Fatalf("expected version 3 after rollback, got %q", bodyStr)
+	}
+
+	// roll back to a specific revision using a prefix of its hash
+	if _, err := deploymentBus.RollbackDeployment(
+		deploymentUrl, deployment.Revisions[0].Hash[:8],
+	); err != nil {
+		t.Fatal(err)
+	}
+	if bodyStr := urlToPageContent(url, t); bodyStr != "version 2" {
+		t.Fatalf("expected version 2 after rollback, got %q", bodyStr)
+	}
+
+	// there's nothing before the oldest retained revision
+	if _, err := deploymentBus.RollbackDeployment(deploymentUrl, ""); err == nil {
+		t.Fatal("expected rollback past the oldest revision to fail")
+	}
+}
+
+func TestGarbageCollection(t *testing.T) {
+
+	// note that createBus configures a one-second grace period
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	deleted := db.Url{Domain: BasicTestHost}
+	kept := db.Url{Domain: OtherTestHost}
+
+	paths := map[string]string{}
+	for _, url := range []db.Url{deleted, kept} {
+		if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: url}); err != nil {
+			t.Fatal(err)
+		}
+		deployment, err := deploymentBus.GetDeploymentByUrl(&url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := deploymentBus.PutStaticFilesForDeployment(
+			deployment,
+			tarGzFromFiles(map[string]string{"index.html": "content for " + url.Domain}, t),
+			false, "tester",
+		); err != nil {
+			t.Fatal(err)
+		}
+		deployment, _ = deploymentBus.GetDeploymentByUrl(&url)
+		paths[url.Domain] = deployment.ServedThing
+	}
+
+	report, err := deploymentBus.CollectGarbage(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Deleted) != 0 || len(report.Pending) != 0 {
+		t.Fatalf("expected nothing to be collected while deployments exist, got %+v", report)
+	}
+
+	if err := deploymentBus.DeleteDeployment(deleted); err != nil {
+		t.Fatal(err)
+	}
+
+	// the deleted deployment's files are now orphaned, but within the grace
+	// period
+	report, err = deploymentBus.CollectGarbage(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Deleted) != 0 || len(report.Pending) != 1 || report.Pending[0].Path != paths[deleted.Domain] {
+		t.Fatalf("expected the deleted deployment's files to be pending, got %+v", report)
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+
+	report, err = deploymentBus.CollectGarbage(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Deleted) != 1 || report.Deleted[0].Size == 0 {
+		t.Fatalf("expected dry run to report one directory, got %+v", report)
+	}
+	if _, err := os.Stat(paths[deleted.Domain]); err != nil {
+		t.Fatal("dry run deleted files")
+	}
+
+	report, err = deploymentBus.CollectGarbage(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Deleted) != 1 {
+		t.Fatalf("expected one directory to be deleted, got %+v", report)
+	}
+	if _, err := os.Stat(paths[deleted.Domain]); !os.IsNotExist(err) {
+		t.Fatal("expected orphaned files to be deleted")
+	}
+	if _, err := os.Stat(paths[kept.Domain]); err != nil {
+		t.Fatal("files for a live deployment were deleted")
+	}
+}
+
+func TestBlobsArePerDeployment(t *testing.T) {
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	site := db.Url{Domain: BasicTestHost}
+	otherSite := db.Url{Domain: OtherTestHost}
+	deployments := map[string]db.Deployment{}
+	for _, url := range []db.Url{site, otherSite} {
+		if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: url}); err != nil {
+			t.Fatal(err)
+		}
+		deployment, err := deploymentBus.GetDeploymentByUrl(&url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		deployments[url.Domain] = deployment
+	}
+
+	hash, err := deploymentBus.PutBlob(deployments[otherSite.Domain], strings.NewReader("secret stuff"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest := []resources.ManifestEntry{{Path: "index.html", Hash: hash}}
+
+	// the blob was only uploaded for the other site, so this one can't find
+	// out that it exists or use it
+	missing, err := deploymentBus.MissingBlobs(deployments[site.Domain], manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 1 || missing[0] != hash {
+		t.Fatalf("expected another deployment's blob to be missing, got %v", missing)
+	}
+	if err := deploymentBus.PutManifestForDeployment(deployments[site.Domain], manifest, "tester"); err == nil {
+		t.Fatal("expected deploying another deployment's blob to fail")
+	}
+
+	missing, err = deploymentBus.MissingBlobs(deployments[otherSite.Domain], manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 0 {
+		t.Fatalf("expected the blob to be available to the deployment it was uploaded for, got %v", missing)
+	}
+
+	// once this site uploads the same content itself, it can use it
+	if _, err := deploymentBus.PutBlob(deployments[site.Domain], strings.NewReader("secret stuff")); err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PutManifestForDeployment(deployments[site.Domain], manifest, "tester"); err != nil {
+		t.Fatal(err)
+	}
+	if content := urlToPageContent("http://"+BasicTestHost, t); content != "secret stuff" {
+		t.Fatalf("expected the uploaded blob to be served, got %s", content)
+	}
+
+	// a new deployment with the same URL as a deleted one doesn't inherit its
+	// uploads
+	if err := deploymentBus.DeleteDeployment(otherSite); err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: otherSite}); err != nil {
+		t.Fatal(err)
+	}
+	recreated, err := deploymentBus.GetDeploymentByUrl(&otherSite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	missing, err = deploymentBus.MissingBlobs(recreated, manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 1 {
+		t.Fatalf("expected a recreated deployment to not have access to old uploads, got %v", missing)
+	}
+
+	// the records of which deployment uploaded what aren't garbage
+	time.Sleep(1100 * time.Millisecond)
+	report, err := deploymentBus.CollectGarbage(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Deleted) != 0 || len(report.Pending) != 0 {
+		t.Fatalf("expected nothing to be collected, got %+v", report)
+	}
+}
+
+func TestPreviewDeployments(t *testing.T) {
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	previewUrl := "http://" + OtherTestHost
+	assertUrlEmpty(previewUrl, t)
+
+	// OtherTestHost is a subdomain of BasicTestHost, so the deployment at
+	// BasicTestHost can have previews there
+	parentUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+		Url:                parentUrl,
+		ExternalSource:     "internet-golf/website#main",
+		ExternalSourceType: db.Github,
+		PreviewDomain:      BasicTestHost,
+		PreviewTTL:         2 * time.Second,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// previews can't be somewhere that the deployment doesn't own, or where
+	// another deployment already has them
+	for _, claim := range []db.DeploymentMetadata{
+		{Url: db.Url{Domain: OtherTestHost}, PreviewDomain: BasicTestHost},
+		{Url: db.Url{Domain: BasicTestHost, Path: "/other/*"}, PreviewDomain: BasicTestHost},
+	} {
+		if err := deploymentBus.SetupDeployment(claim); err == nil {
+			t.Fatalf("expected %s to not be allowed previews under %s", claim.Url, claim.PreviewDomain)
+		}
+	}
+
+	for _, invalid := range []string{"internet-golf-test.local", "-bad." + BasicTestHost, "a.b." + BasicTestHost, OtherTestHost + "/path"} {
+		if _, err := deploymentBus.FindPreviewParent(urlFromTestString(invalid)); err == nil {
+			t.Fatalf("expected %s to be rejected as a preview URL", invalid)
+		}
+	}
+
+	parent, err := deploymentBus.FindPreviewParent(db.Url{Domain: OtherTestHost})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parent.Url.Equals(&parentUrl) {
+		t.Fatalf("found the wrong parent deployment: %s", parent.Url)
+	}
+
+	preview, err := deploymentBus.SetupPreviewDeployment(parent, db.Url{Domain: OtherTestHost})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.ExternalSource != "internet-golf/website" || !preview.PreviewOf.Equals(&parentUrl) {
+		t.Fatalf("preview was not set up based on its parent: %+v", preview.DeploymentMetadata)
+	}
+
+	// changing the preview's settings shouldn't make it stop being a preview
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+		Url: preview.Url, Name: "renamed preview",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	preview, err = deploymentBus.GetDeploymentByUrl(&preview.Url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.ExpiresAt.IsZero() || !preview.PreviewOf.Equals(&parentUrl) {
+		t.Fatalf("updating the preview's settings lost its expiry: %+v", preview.DeploymentMetadata)
+	}
+
+	if err := deploymentBus.PutStaticFilesForDeployment(
+		preview, tarGzFromFiles(map[string]string{"index.html": "preview"}, t), false, "tester",
+	); err != nil {
+		t.Fatal(err)
+	}
+	if bodyStr := urlToPageContent(previewUrl, t); bodyStr != "preview" {
+		t.Fatalf("expected preview content, got %q", bodyStr)
+	}
+
+	if expired := deploymentBus.DeleteExpiredPreviews(); len(expired) != 0 {
+		t.Fatalf("preview was deleted before it expired: %v", expired)
+	}
+
+	time.Sleep(2500 * time.Millisecond)
+
+	if expired := deploymentBus.DeleteExpiredPreviews(); len(expired) != 1 {
+		t.Fatalf("expected the preview to have expired, got %v", expired)
+	}
+	if _, err := deploymentBus.GetDeploymentByUrl(&preview.Url); err == nil {
+		t.Fatal("expired preview still exists")
+	}
+	if _, err := deploymentBus.GetDeploymentByUrl(&parentUrl); err != nil {
+		t.Fatal("parent deployment was deleted along with the preview")
+	}
+	assertUrlEmpty(previewUrl, t)
+}
+
+func TestPreviewsUseCurrentDeployments(t *testing.T) {
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	parentUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+		Url:           parentUrl,
+		Name:          "parent",
+		PreviewDomain: BasicTestHost,
+		PreviewTTL:    time.Second,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	parent, err := deploymentBus.GetDeploymentByUrl(&parentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	previewUrl := db.Url{Domain: OtherTestHost}
+
+	// a preview can't take over a deployment that's created at the same url at
+	// the same time; whichever one happens last, the other deployment's
+	// settings should win
+	for range 5 {
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: previewUrl, Name: "permanent"})
+		}()
+		go func() {
+			defer wg.Done()
+			deploymentBus.SetupPreviewDeployment(parent, previewUrl)
+		}()
+		wg.Wait()
+		deployment, err := deploymentBus.GetDeploymentByUrl(&previewUrl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if deployment.Name != "permanent" {
+			t.Fatalf("expected a preview to not replace another deployment, got %+v", deployment.DeploymentMetadata)
+		}
+		if err := deploymentBus.DeleteDeployment(previewUrl); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// refreshing a preview after it expires, but before it's deleted, keeps it
+	// around
+	if _, err := deploymentBus.SetupPreviewDeployment(parent, previewUrl); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(1100 * time.Millisecond)
+	if _, err := deploymentBus.SetupPreviewDeployment(parent, previewUrl); err != nil {
+		t.Fatal(err)
+	}
+	if expired := deploymentBus.DeleteExpiredPreviews(); len(expired) != 0 {
+		t.Fatalf("expected a refreshed preview to not be deleted, got %v", expired)
+	}
+	if _, err := deploymentBus.GetDeploymentByUrl(&previewUrl); err != nil {
+		t.Fatal("refreshed preview was deleted")
+	}
+}
+
+func urlFromTestString(url string) db.Url {
+	domain, path, found := strings.Cut(url, "/")
+	if !found {
+		return db.Url{Domain: domain}
+	}
+	return db.Url{Domain: domain, Path: "/" + path}
+}
+
+func TestPreserveExistingFiles(t *testing.T) {
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	url := "http://" + BasicTestHost
+	assertUrlEmpty(url, t)
+
+	deploymentUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: deploymentUrl}); err != nil {
+		t.Fatal(err)
+	}
+	deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PutStaticFilesForDeployment(
+		deployment,
+		tarGzFromFiles(map[string]string{
+			"index.html":       "version 1",
+			"old.txt":          "old",
+			"nested/thing.txt": "thing",
+		}, t),
+		false, "tester",
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	deployment, err = deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PatchStaticFilesForDeployment(
+		deployment,
+		tarGzFromFiles(map[string]string{"index.html": "version 2"}, t),
+		false, []string{"old.txt"}, "tester",
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if bodyStr := urlToPageContent(url, t); bodyStr != "version 2" {
+		t.Fatalf("expected the uploaded file to replace the existing one, got %q", bodyStr)
+	}
+	if bodyStr := urlToPageContent(url+"/nested/thing.txt", t); bodyStr != "thing" {
+		t.Fatalf("expected the existing file to be preserved, got %q", bodyStr)
+	}
+	if bodyStr := urlToPageContent(url+"/old.txt", t); len(bodyStr) > 0 {
+		t.Fatalf("expected the deleted file to be gone, got %q", bodyStr)
+	}
+
+	// the previous revision's files weren't touched, so it can be rolled back to
+	if _, err := deploymentBus.RollbackDeployment(deploymentUrl, ""); err != nil {
+		t.Fatal(err)
+	}
+	if bodyStr := urlToPageContent(url, t); bodyStr != "version 1" {
+		t.Fatalf("expected version 1 after rollback, got %q", bodyStr)
+	}
+	if bodyStr := urlToPageContent(url+"/old.txt", t); bodyStr != "old" {
+		t.Fatalf("expected the deleted file to be back after rollback, got %q", bodyStr)
+	}
+
+	if err := deploymentBus.PatchStaticFilesForDeployment(
+		deployment, tarGzFromFiles(map[string]string{"index.html": "version 3"}, t),
+		false, []string{"../outside"}, "tester",
+	); err == nil {
+		t.Fatal("expected deleting a path outside of the deployment to fail")
+	}
+}
+
+func TestArchiveFormats(t *testing.T) {
+	deploymentBus := createBusWithConfig(func(config *utils.Config) {
+		config.MaxArchiveEntries = 10
+		config.MaxExtractedSize = 1024
+	})
+	defer deploymentBus.Stop()
+
+	url := "http://" + BasicTestHost
+	assertUrlEmpty(url, t)
+
+	deploymentUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: deploymentUrl}); err != nil {
+		t.Fatal(err)
+	}
+	deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formats := map[string]archives.Archiver{
+		"zip":     archives.Zip{},
+		"tar":     archives.Tar{},
+		"tar.zst": archives.CompressedArchive{Compression: archives.Zstd{}, Archival: archives.Tar{}},
+		"tar.xz":  archives.CompressedArchive{Compression: archives.Xz{}, Archival: archives.Tar{}},
+	}
+	for name, format := range formats {
+		// the leading "dist" directory should be trimmed no matter the format
+		archive := archiveFromFiles(map[string]string{
+			"dist/index.html":      name,
+			"dist/nested/page.txt": "nested " + name,
+		}, format, t)
+		if err := deploymentBus.PutStaticFilesForDeployment(deployment, archive, false, "tester"); err != nil {
+			t.Fatalf("could not deploy %s: %v", name, err)
+		}
+		if bodyStr := urlToPageContent(url, t); bodyStr != name {
+			t.Fatalf("expected %q, got %q", name, bodyStr)
+		}
+		if bodyStr := urlToPageContent(url+"/nested/page.txt", t); bodyStr != "nested "+name {
+			t.Fatalf("expected %q, got %q", "nested "+name, bodyStr)
+		}
+	}
+
+	if err := deploymentBus.PutStaticFilesForDeployment(
+		deployment, tarGzFromFiles(map[string]string{"../escape.txt": "oops"}, t), false, "tester",
+	); err == nil {
+		t.Fatal("expected an archive with a path outside of the deployment to be rejected")
+	}
+
+	tooMany := map[string]string{}
+	for i := range 11 {
+		tooMany[fmt.Sprintf("%d.txt", i)] = "x"
+	}
+	var limitErr resources.ArchiveLimitError
+	err = deploymentBus.PutStaticFilesForDeployment(deployment, tarGzFromFiles(tooMany, t), false, "tester")
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("expected an archive with too many entries to be rejected, got %v", err)
+	}
+
+	// this compresses down to almost nothing, like a zip bomb would
+	err = deploymentBus.PutStaticFilesForDeployment(
+		deployment,
+		archiveFromFiles(map[string]string{"big.txt": strings.Repeat("a", 2048)}, archives.Zip{}, t),
+		false, "tester",
+	)
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("expected an archive that is too big when extracted to be rejected, got %v", err)
+	}
+
+	// the last successful deployment should still be there
+	if bodyStr := urlToPageContent(url+"/nested/page.txt", t); !strings.HasPrefix(bodyStr, "nested ") {
+		t.Fatalf("expected the previous content to still be served, got %q", bodyStr)
+	}
+}
+
+func TestRouteUpdates(t *testing.T) {
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	basicUrl := db.Url{Domain: BasicTestHost}
+	otherUrl := db.Url{Domain: OtherTestHost}
+	pathUrl := db.Url{Domain: BasicTestHost, Path: "/stuff/*"}
+
+	// routes are added to caddy one at a time, and the more specific one
+	// has to end up in front of the less specific one
+	for _, setup := range []struct {
+		url     db.Url
+		fixture string
+	}{
+		{basicUrl, "static-site"},
+		{otherUrl, "static-site-2"},
+		{pathUrl, "static-site-2"},
+	} {
+		deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: setup.url})
+		deploymentBus.PutDeploymentContentByUrl(setup.url, db.DeploymentContent{
+			ServedThingType: db.StaticFiles,
+			ServedThing:     getFixturePath(setup.fixture),
+		})
+	}
+
+	expectContent := func(url string, expected string) {
+		t.Helper()
+		if content := urlToPageContent(url, t); content != expected {
+			t.Fatalf("expected %q at %s, got %q", expected, url, content)
+		}
+	}
+
+	expectContent("http://"+BasicTestHost, "stuff\n")
+	expectContent("http://"+BasicTestHost+"/stuff/", "stuff 2\n")
+	expectContent("http://"+OtherTestHost, "stuff 2\n")
+
+	// changing one deployment shouldn't affect the others
+	deploymentBus.PutDeploymentContentByUrl(basicUrl, db.DeploymentContent{
+		ServedThingType: db.StaticFiles,
+		ServedThing:     getFixturePath("static-site-2"),
+	})
+	expectContent("http://"+BasicTestHost, "stuff 2\n")
+	expectContent("http://"+BasicTestHost+"/stuff/", "stuff 2\n")
+	expectContent("http://"+OtherTestHost, "stuff 2\n")
+
+	// and once the more specific deployment is gone, the less specific one
+	// gets its requests
+	if err := deploymentBus.DeleteDeployment(pathUrl); err != nil {
+		t.Fatal(err)
+	}
+	deploymentBus.PutDeploymentContentByUrl(basicUrl, db.DeploymentContent{
+		ServedThingType: db.StaticFiles,
+		ServedThing:     getFixturePath("static-site"),
+	})
+	expectContent("http://"+BasicTestHost, "stuff\n")
+	expectContent("http://"+BasicTestHost+"/stuff/", "")
+	expectContent("http://"+OtherTestHost, "stuff 2\n")
+}
+
+func TestHeaderRules(t *testing.T) {
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	getHeaders := func(url string) http.Header {
+		t.Helper()
+		resp, err := http.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.Header
+	}
+
+	staticUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+		Url:             staticUrl,
+		SecurityHeaders: []string{"hsts", "frame-options"},
+		HeaderRules: []db.HeaderRule{
+			// overrides the preset
+			{Operation: db.SetHeader, Name: "X-Frame-Options", Value: "DENY"},
+			{Operation: db.SetHeader, Name: "Cache-Control", Value: "max-age=60", Path: "/nested/*"},
+			{Operation: db.DeleteHeader, Name: "X-Deployed-By"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	deploymentBus.PutDeploymentContentByUrl(staticUrl, db.DeploymentContent{
+		ServedThingType: db.StaticFiles,
+		ServedThing:     getFixturePath("static-site"),
+	})
+
+	headers := getHeaders("http://" + BasicTestHost)
+	if headers.Get("Strict-Transport-Security") != public.SecurityHeaderPresets["hsts"].Value {
+		t.Fatalf("expected hsts header, got %q", headers.Get("Strict-Transport-Security"))
+	}
+	if headers.Get("X-Frame-Options") != "DENY" {
+		t.Fatalf("expected X-Frame-Options to be overridden, got %q", headers.Get("X-Frame-Options"))
+	}
+	if len(headers.Get("Cache-Control")) > 0 {
+		t.Fatalf("expected no Cache-Control outside of /nested/, got %q", headers.Get("Cache-Control"))
+	}
+	if len(headers.Get("X-Deployed-By")) > 0 {
+		t.Fatal("expected X-Deployed-By to be deleted")
+	}
+	if cacheControl := getHeaders("http://" + BasicTestHost + "/nested/concept.txt").Get("Cache-Control"); cacheControl != "max-age=60" {
+		t.Fatalf("expected Cache-Control for /nested/, got %q", cacheControl)
+	}
+
+	// rules also apply to (and override) headers from reverse proxy upstreams
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Upstream", "secret")
+		w.Header().Set("Cache-Control", "no-store")
+		fmt.Fprint(w, "proxied")
+	}))
+	defer upstream.Close()
+
+	proxyUrl := db.Url{Domain: OtherTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+		Url: proxyUrl,
+		HeaderRules: []db.HeaderRule{
+			{Operation: db.DeleteHeader, Name: "X-Upstream"},
+			{Operation: db.SetHeader, Name: "Cache-Control", Value: "max-age=5"},
+			{Operation: db.AddHeader, Name: "X-Golf-Test", Value: "added"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PutReverseProxyDeployment(proxyUrl, db.DeploymentContent{
+		ProxyUpstreams: []string{upstream.Listener.Addr().String()},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// caddy placeholders would let anyone who can change the rules read the
+	// server's environment variables and files
+	t.Setenv("GOLF_TEST_SECRET", "hunter2")
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+		Url: staticUrl,
+		HeaderRules: []db.HeaderRule{
+			{Operation: db.SetHeader, Name: "X-Leak", Value: "{env.GOLF_TEST_SECRET}"},
+		},
+	}); err == nil {
+		t.Fatal("expected a header rule with a placeholder to be rejected")
+	}
+	for _, values := range getHeaders("http://" + BasicTestHost) {
+		if slices.ContainsFunc(values, func(v string) bool { return strings.Contains(v, "hunter2") }) {
+			t.Fatalf("expected the environment variable to not be expanded, got %v", values)
+		}
+	}
+
+	headers = getHeaders("http://" + OtherTestHost)
+	if len(headers.Get("X-Upstream")) > 0 {
+		t.Fatal("expected X-Upstream to be deleted")
+	}
+	if headers.Get("Cache-Control") != "max-age=5" {
+		t.Fatalf("expected upstream Cache-Control to be overridden, got %q", headers.Get("Cache-Control"))
+	}
+	if headers.Get("X-Golf-Test") != "added" {
+		t.Fatalf("expected X-Golf-Test to be added, got %q", headers.Get("X-Golf-Test"))
+	}
+}
+
+func TestSiteConfigFiles(t *testing.T) {
+	t.Setenv("GOLF_TEST_SECRET", "hunter2")
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	// the redirects should be seen as they are, not followed
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	get := func(url string) *http.Response {
+		t.Helper()
+		resp, err := client.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	// deployed under a path, so that the redirects have to account for it
+	deploymentUrl := db.Url{Domain: BasicTestHost, Path: "/site"}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: deploymentUrl}); err != nil {
+		t.Fatal(err)
+	}
+	deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PutStaticFilesForDeployment(
+		deployment,
+		tarGzFromFiles(map[string]string{
+			"index.html":     "home",
+			"shadowed.html":  "shadowed",
+			"app/index.html": "app",
+			"_redirects": strings.Join([]string{
+				"# comments are ignored",
+				"/old /new",
+				"/blog/:year/:slug /posts/:year-:slug 302",
+				"/docs/* https://docs.example.com/:splat 307",
+				"/shadowed.html /elsewhere",
+				"/forced.html /elsewhere 301!",
+				"/app/* /app/index.html 200",
+				"/this line is wrong",
+				"/bad /worse 999",
+				"/leak https://evil.example/{env.GOLF_TEST_SECRET}",
+			}, "\n"),
+			"_headers": strings.Join([]string{
+				"/*",
+				"  X-Site: everywhere",
+				"  X-Leak: {env.GOLF_TEST_SECRET}",
+				"/app/*",
+				"  Cache-Control: no-cache",
+				"  Cache-Control: no-store",
+			}, "\n"),
+		}, t),
+		false, "tester",
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	base := "http://" + BasicTestHost + "/site"
+	expectRedirect := func(path string, status int, location string) {
+		t.Helper()
+		resp := get(base + path)
+		if resp.StatusCode != status || resp.Header.Get("Location") != location {
+			t.Fatalf(
+				"expected %s to redirect to %s with %d, got %d to %q",
+				path, location, status, resp.StatusCode, resp.Header.Get("Location"),
+			)
+		}
+	}
+	expectRedirect("/old", 301, "/site/new")
+	expectRedirect("/old/", 301, "/site/new")
+	expectRedirect("/blog/2024/hello", 302, "/site/posts/2024-hello")
+	expectRedirect("/docs/setup/install", 307, "https://docs.example.com/setup/install")
+	expectRedirect("/forced.html", 301, "/site/elsewhere")
+
+	// files that exist win over rules without a "!"
+	if resp := get(base + "/shadowed.html"); resp.StatusCode != 200 {
+		t.Fatalf("expected the existing file to be served, got %d", resp.StatusCode)
+	}
+	if bodyStr := urlToPageContent(base+"/app/some/route", t); bodyStr != "app" {
+		t.Fatalf("expected the rewrite to serve the app's index, got %q", bodyStr)
+	}
+
+	resp := get(base + "/app/some/route")
+	if resp.Header.Get("Cache-Control") != "no-cache, no-store" {
+		t.Fatalf("expected the combined Cache-Control header, got %q", resp.Header.Get("Cache-Control"))
+	}
+	if resp.Header.Get("X-Site") != "everywhere" {
+		t.Fatalf("expected X-Site everywhere, got %q", resp.Header.Get("X-Site"))
+	}
+	if resp := get(base + "/"); len(resp.Header.Get("Cache-Control")) > 0 {
+		t.Fatalf("expected no Cache-Control outside of /app/, got %q", resp.Header.Get("Cache-Control"))
+	}
+
+	// caddy placeholders would give away the server's environment variables
+	// and files, so the lines with them are left out
+	resp = get(base + "/leak")
+	if resp.StatusCode != 404 || len(resp.Header.Get("Location")) > 0 || len(resp.Header.Get("X-Leak")) > 0 {
+		t.Fatalf("expected placeholders to be rejected, got %d with headers %v", resp.StatusCode, resp.Header)
+	}
+
+	for _, file := range []string{"/_redirects", "/_headers"} {
+		if resp := get(base + file); resp.StatusCode != 404 {
+			t.Fatalf("expected %s to not be served, got %d", file, resp.StatusCode)
+		}
+	}
+
+	deployment, err = deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deployment.SiteConfigErrors) != 4 {
+		t.Fatalf("expected 4 errors from the bad lines, got %v", deployment.SiteConfigErrors)
+	}
+	if len(deployment.Redirects) != 6 {
+		t.Fatalf("expected the good redirect rules to be kept, got %v", deployment.Redirects)
+	}
+}
+
+func TestErrorPages(t *testing.T) {
+	defaultPage := path.Join(t.TempDir(), "default.html")
+	if err := os.WriteFile(defaultPage, []byte("nothing here"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	deploymentBus := createBusWithConfig(func(config *utils.Config) {
+		config.DefaultPage = defaultPage
+	})
+	defer deploymentBus.Stop()
+
+	getPage := func(url string) (int, string, http.Header) {
+		t.Helper()
+		resp, err := http.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(body), resp.Header
+	}
+
+	// the default page is shown for hosts without a deployment
+	if status, body, _ := getPage("http://" + OtherTestHost); status != 404 || body != "nothing here" {
+		t.Fatalf("expected the default page with a 404, got %d %q", status, body)
+	}
+
+	deploymentUrl := db.Url{Domain: BasicTestHost, Path: "/site"}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+		Url:         deploymentUrl,
+		HeaderRules: []db.HeaderRule{{Operation: db.SetHeader, Name: "X-Golf-Test", Value: "yes"}},
+		ErrorPages:  map[string]string{"4xx": "/4xx.html", "404": "/errors/404.html"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PutStaticFilesForDeployment(
+		deployment,
+		tarGzFromFiles(map[string]string{
+			"index.html":      "home",
+			"4xx.html":        "client error",
+			"errors/404.html": "not found",
+		}, t),
+		false, "tester",
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	base := "http://" + BasicTestHost + "/site"
+	if status, body, _ := getPage(base + "/"); status != 200 || body != "home" {
+		t.Fatalf("expected the index page, got %d %q", status, body)
+	}
+	// the exact status code wins over the range
+	status, body, headers := getPage(base + "/missing.html")
+	if status != 404 || body != "not found" {
+		t.Fatalf("expected the 404 page with a 404, got %d %q", status, body)
+	}
+	if headers.Get("X-Golf-Test") != "yes" {
+		t.Fatalf("expected the header rules to apply to error pages, got %q", headers.Get("X-Golf-Test"))
+	}
+
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+		Url:        deploymentUrl,
+		ErrorPages: map[string]string{"4xx": "/4xx.html"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if status, body, _ := getPage(base + "/missing.html"); status != 404 || body != "client error" {
+		t.Fatalf("expected the 4xx page with a 404, got %d %q", status, body)
+	}
+}
+
+func TestAccessControl(t *testing.T) {
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	type request struct {
+		path     string
+		username string
+		password string
+		cookie   *http.Cookie
+	}
+	getResponse := func(host string, r request) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, "http://"+host+r.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.username) > 0 {
+			req.SetBasicAuth(r.username, r.password)
+		}
+		if r.cookie != nil {
+			req.AddCookie(r.cookie)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+	expectStatus := func(host string, r request, status int) *http.Response {
+		t.Helper()
+		resp := getResponse(host, r)
+		if resp.StatusCode != status {
+			t.Fatalf("expected %d for %s%s, got %d", status, host, r.path, resp.StatusCode)
+		}
+		return resp
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shareToken := "abcdefghijklmnop1234"
+	protectedUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+		Url: protectedUrl,
+		AccessControl: db.AccessControl{
+			BasicAuthUsers: []db.BasicAuthUser{{Username: "golfer", PasswordHash: string(passwordHash)}},
+			ShareToken:     shareToken,
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	deploymentBus.PutDeploymentContentByUrl(protectedUrl, db.DeploymentContent{
+		ServedThingType: db.StaticFiles,
+		ServedThing:     getFixturePath("static-site"),
+	})
+
+	expectStatus(BasicTestHost, request{path: "/"}, 401)
+	expectStatus(BasicTestHost, request{path: "/", username: "golfer", password: "wrong"}, 401)
+	expectStatus(BasicTestHost, request{path: "/", username: "golfer", password: "hunter2"}, 200)
+
+	// the share token works in the query string, and then in the cookie that
+	// it's put in
+	resp := expectStatus(BasicTestHost, request{path: "/?" + public.ShareTokenParam + "=" + shareToken}, 200)
+	var shareCookie *http.Cookie
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == public.ShareTokenParam {
+			shareCookie = cookie
+		}
+	}
+	if shareCookie == nil || shareCookie.Value != shareToken {
+		t.Fatalf("expected a cookie with the share token, got %v", resp.Cookies())
+	}
+	expectStatus(BasicTestHost, request{path: "/nested/concept.txt", cookie: shareCookie}, 200)
+	expectStatus(BasicTestHost, request{
+		path: "/", cookie: &http.Cookie{Name: public.ShareTokenParam, Value: "wrongwrongwrongwrong"},
+	}, 401)
+
+	// aliases that serve a protected deployment are protected too
+	aliasUrl := db.Url{Domain: OtherTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: aliasUrl}); err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PutAliasDeployment(aliasUrl, protectedUrl, false); err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(OtherTestHost, request{path: "/"}, 401)
+	expectStatus(OtherTestHost, request{path: "/", username: "golfer", password: "hunter2"}, 200)
+
+	// ip restrictions apply to all types of deployments. the test requests
+	// come from localhost
+	for _, test := range []struct {
+		accessControl db.AccessControl
+		status        int
+	}{
+		{db.AccessControl{DeniedIps: []string{"127.0.0.1", "::1"}}, 403},
+		{db.AccessControl{AllowedIps: []string{"10.0.0.0/8"}}, 403},
+		{db.AccessControl{AllowedIps: []string{"127.0.0.0/8", "::1/128"}}, 200},
+		{db.AccessControl{AllowedIps: []string{"127.0.0.0/8", "::1/128"}, DeniedIps: []string{"127.0.0.1", "::1"}}, 403},
+	} {
+		if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{
+			Url: aliasUrl, AccessControl: test.accessControl,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		expectStatus(OtherTestHost, request{path: "/"}, test.status)
+	}
+}
+
+func TestConcurrentDeploymentsAndDeletions(t *testing.T) {
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	const workers = 8
+	stressUrl := func(i int) db.Url {
+		return db.Url{Domain: BasicTestHost, Path: fmt.Sprintf("/stress-%d/*", i)}
+	}
+
+	// something that keeps reading the deployments while they're changing,
+	// like GET /deployments would
+	done := make(chan struct{})
+	readerDone := make(chan struct{})
+	go func() {
+		defer close(readerDone)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			for _, d := range deploymentBus.Deployments() {
+				_ = len(d.Revisions) + len(d.ServedThing) + len(d.MetaInfo.Title)
+			}
+		}
+	}()
+
+	errs := make(chan error, workers*3)
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			url := stressUrl(i)
+			if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: url}); err != nil {
+				errs <- err
+				return
+			}
+			if err := deploymentBus.PutDeploymentContentByUrl(url, db.DeploymentContent{
+				ServedThingType: db.StaticFiles,
+				ServedThing:     getFixturePath("static-site-2"),
+			}); err != nil {
+				errs <- err
+				return
+			}
+			if _, err := deploymentBus.GetDeploymentByUrl(&url); err != nil {
+				errs <- err
+				return
+			}
+			// every other deployment is deleted again
+			if i%2 == 1 {
+				if err := deploymentBus.DeleteDeployment(url); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(done)
+	<-readerDone
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	if len(deploymentBus.Deployments()) != workers/2 {
+		t.Fatalf("expected %d deployments, got %d", workers/2, len(deploymentBus.Deployments()))
+	}
+	for i := range workers {
+		url := stressUrl(i)
+		_, err := deploymentBus.GetDeploymentByUrl(&url)
+		bodyStr := urlToPageContent(fmt.Sprintf("http://%s/stress-%d/", BasicTestHost, i), t)
+		if i%2 == 0 && (err != nil || bodyStr != "stuff 2\n") {
+			t.Fatalf("expected %s to be deployed, got %v and %q", url, err, bodyStr)
+		}
+		if i%2 == 1 && (err == nil || len(bodyStr) > 0) {
+			t.Fatalf("expected %s to be deleted", url)
+		}
+	}
+}
+
+func TestFailedUpdateIsRolledBack(t *testing.T) {
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	url := db.Url{Domain: BasicTestHost}
+	deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: url})
+	deploymentBus.PutDeploymentContentByUrl(url, db.DeploymentContent{
+		ServedThingType: db.StaticFiles,
+		ServedThing:     getFixturePath("static-site"),
+	})
+
+	// caddy won't accept this (PutReverseProxyDeployment would have caught
+	// it), so deploying it fails
+	err := deploymentBus.PutDeploymentContentByUrl(url, db.DeploymentContent{
+		ServedThingType:    db.ReverseProxy,
+		ServedThing:        "localhost:1",
+		ProxyLoadBalancing: "not-a-policy",
+	})
+	if err == nil {
+		t.Fatal("expected invalid proxy settings to fail to deploy")
+	}
+
+	deployment, err := deploymentBus.GetDeploymentByUrl(&url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deployment.ServedThingType != db.StaticFiles {
+		t.Fatal("the failed change was kept")
+	}
+	if bodyStr := urlToPageContent("http://"+BasicTestHost, t); bodyStr != "stuff\n" {
+		t.Fatalf("expected stuff\\n, got %v", []byte(bodyStr))
+	}
+}
+
+func TestDeletedDeploymentsStayDeleted(t *testing.T) {
+	dataDir, err := os.MkdirTemp("", "internet-golf-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempDirs = append(tempDirs, dataDir)
+
+	deploymentBus, database := openBus(dataDir, nil)
+
+	deleted := db.Url{Domain: BasicTestHost}
+	kept := db.Url{Domain: OtherTestHost}
+	alias := db.Url{Domain: BasicTestHost, Path: "/alias/*"}
+	for _, url := range []db.Url{deleted, kept} {
+		if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: url}); err != nil {
+			t.Fatal(err)
+		}
+		if err := deploymentBus.PutDeploymentContentByUrl(url, db.DeploymentContent{
+			ServedThingType: db.StaticFiles,
+			ServedThing:     getFixturePath("static-site"),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: alias}); err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PutAliasDeployment(alias, deleted, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// this also deletes the alias
+	if err := deploymentBus.DeleteDeployment(deleted); err != nil {
+		t.Fatal(err)
+	}
+
+	// "restart" the server
+	deploymentBus.Stop()
+	if err := database.Close(); err != nil {
+		t.Fatal(err)
+	}
+	deploymentBus, database = openBus(dataDir, nil)
+	defer database.Close()
+	defer deploymentBus.Stop()
+
+	urls := []string{}
+	for _, d := range deploymentBus.Deployments() {
+		urls = append(urls, d.Url.String())
+	}
+	if len(urls) != 1 || urls[0] != kept.String() {
+		t.Fatalf("expected only %s to be left after restarting, got %v", kept, urls)
+	}
+	if bodyStr := urlToPageContent("http://"+BasicTestHost, t); len(bodyStr) > 0 {
+		t.Fatalf("expected %s to stay deleted, got %q", deleted, bodyStr)
+	}
+	if bodyStr := urlToPageContent("http://"+OtherTestHost, t); bodyStr != "stuff\n" {
+		t.Fatalf("expected stuff\\n, got %v", []byte(bodyStr))
+	}
+}
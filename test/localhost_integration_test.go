@@ -11,19 +11,27 @@ package internetgolf_test
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"path"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/gosimple/slug"
 	golfsdk "github.com/internet-golf/internet-golf/client-sdk"
 	"github.com/internet-golf/internet-golf/pkg/api"
 	database "github.com/internet-golf/internet-golf/pkg/db"
 	"github.com/internet-golf/internet-golf/pkg/public"
 	"github.com/internet-golf/internet-golf/pkg/resources"
 	"github.com/internet-golf/internet-golf/pkg/utils"
+	"github.com/mholt/archives"
 )
 
 // test case stuff =======================================================
@@ -68,18 +76,70 @@ var testCases = []TestCase{
 			}
 		},
 	},
+	{
+		name:       "Only upload files that the server doesn't have",
+		cliCommand: "deploy-content internet-golf-test.local --files ./fixtures/static-site",
+		deploymentTest: func(t *testing.T, client *golfsdk.APIClient) {
+			manifest := golfsdk.ManifestBody{Url: "internet-golf-test.local"}
+			for _, file := range []string{"index.html", "nested/concept.txt"} {
+				contents, err := os.ReadFile(path.Join("./fixtures/static-site", file))
+				if err != nil {
+					t.Fatal(err)
+				}
+				hash := sha256.Sum256(contents)
+				manifest.Files = append(manifest.Files, golfsdk.ManifestFileModel{
+					Path: file, Hash: hex.EncodeToString(hash[:]),
+				})
+			}
+			newHash := sha256.Sum256([]byte("new file"))
+			manifest.Files = append(manifest.Files, golfsdk.ManifestFileModel{
+				Path: "new.txt", Hash: hex.EncodeToString(newHash[:]),
+			})
+
+			output, _, err := client.DefaultAPI.CheckManifest(context.TODO()).ManifestBody(manifest).Execute()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(output.Missing) != 1 || output.Missing[0] != hex.EncodeToString(newHash[:]) {
+				t.Fatalf("expected only the new file to be missing, got %v", output.Missing)
+			}
+
+			// deploying the same files again shouldn't create a new revision
+			revisions, _, err := client.DefaultAPI.GetRevisions(context.TODO(), "internet-golf-test.local").Execute()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(revisions.Revisions) != 1 {
+				t.Fatalf("expected 1 revision, got %d", len(revisions.Revisions))
+			}
+		},
+	},
+	{
+		name:       "Upload files as a tarball",
+		cliCommand: "deploy-content internet-golf-test.local --files ./fixtures/static-site --tarball",
+		deploymentTest: func(t *testing.T, _ *golfsdk.APIClient) {
+			if content := urlToPageContent("http://internet-golf-test.local/nested/concept.txt", t); content != "fnord" {
+				t.Fatalf("expected fnord, got %v", []byte(content))
+			}
+		},
+	},
 }
 
 // server setup ===========================================================
 
 func startFullServer(port string) func() {
+	return startFullServerWithConfig(port, func(*utils.Config) {})
+}
+
+func startFullServerWithConfig(port string, configure func(*utils.Config)) func() {
 	tempDir, tempDirError := os.MkdirTemp("", "internet-golf-test")
 	if tempDirError != nil {
 		panic(tempDirError)
 	}
 	tempDirs = append(tempDirs, tempDir)
 
-	config := utils.NewConfig(tempDir, true, true, port)
+	config := utils.NewConfig(tempDir, true, true, port, 10, 0, time.Minute)
+	configure(config)
 
 	fileManager := resources.NewFileManager(config)
 
@@ -93,7 +153,14 @@ func startFullServer(port string) func() {
 		panic(err)
 	}
 
-	deploymentBus, err := api.NewDeploymentBus(deploymentServer, db, fileManager)
+	containerManager, err := resources.NewContainerManager("")
+	if err != nil {
+		panic(err)
+	}
+
+	deploymentBus, err := api.NewDeploymentBus(
+		config, deploymentServer, db, fileManager, containerManager, resources.NewProcessManager(),
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -115,6 +182,9 @@ func startFullServer(port string) func() {
 		})
 
 	server := adminApi.CreateServer()
+	if err := adminApi.ServeSocket(server); err != nil {
+		panic(err)
+	}
 	listener, err := net.Listen("tcp", server.Addr)
 	if err != nil {
 		panic(err)
@@ -156,3 +226,104 @@ func TestClientCli(t *testing.T) {
 		})
 	}
 }
+
+func TestUploadSizeLimit(t *testing.T) {
+	serverPortInt, portErr := utils.GetFreePort()
+	if portErr != nil {
+		panic(portErr)
+	}
+	serverPort := strconv.Itoa(serverPortInt)
+	client := createClient("http://127.0.0.1:" + serverPort)
+
+	var dataDirectory string
+	stopServer := startFullServerWithConfig(serverPort, func(config *utils.Config) {
+		config.MaxUploadSize = 4096
+		dataDirectory = config.DataDirectory
+	})
+	defer stopServer()
+
+	runClientCliCommand("create-deployment "+BasicTestHost, serverPort, t)
+
+	// random data doesn't compress, so this stays over the limit
+	bigFile := make([]byte, 64*1024)
+	rand.Read(bigFile)
+	archive := archiveFromFiles(map[string]string{"big.bin": string(bigFile)}, archives.CompressedArchive{
+		Compression: archives.Gz{},
+		Archival:    archives.Tar{},
+	}, t)
+	archivePath := path.Join(t.TempDir(), "big.tar.gz")
+	archiveFile, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.Copy(archiveFile, archive); err != nil {
+		t.Fatal(err)
+	}
+	archiveFile.Seek(0, 0)
+
+	_, resp, err := client.DefaultAPI.DeployFiles(context.TODO()).
+		Url(BasicTestHost).Contents(archiveFile).Execute()
+	if err == nil || resp == nil || resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected a 413 response, got %v (%v)", resp, err)
+	}
+
+	// nothing from the rejected upload should have been left behind
+	deploymentDir := path.Join(dataDirectory, slug.Make(BasicTestHost))
+	if entries, err := os.ReadDir(deploymentDir); err == nil && len(entries) > 0 {
+		t.Fatalf("expected no files to be left in %s, found %d", deploymentDir, len(entries))
+	}
+
+	// blobs for manifest deployments have the same limit
+	bigBlobPath := path.Join(t.TempDir(), "big.bin")
+	if err := os.WriteFile(bigBlobPath, bigFile, 0644); err != nil {
+		t.Fatal(err)
+	}
+	bigBlob, err := os.Open(bigBlobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bigBlob.Close()
+	_, resp, err = client.DefaultAPI.UploadBlobs(context.TODO()).
+		Url(BasicTestHost).Blobs([]*os.File{bigBlob}).Execute()
+	if err == nil || resp == nil || resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected a 413 response for a big blob, got %v (%v)", resp, err)
+	}
+
+	// and so do image archives and executables. (the client closes files once
+	// it's sent them, so they have to be opened again)
+	bigArchive, err := os.Open(bigBlobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bigArchive.Close()
+	_, resp, err = client.DefaultAPI.DeployContainer(context.TODO()).
+		Url(BasicTestHost).Image("golf-test-app:latest").Port(8080).ImageArchive(bigArchive).Execute()
+	if err == nil || resp == nil || resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected a 413 response for a big image archive, got %v (%v)", resp, err)
+	}
+	bigExecutable, err := os.Open(bigBlobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bigExecutable.Close()
+	_, resp, err = client.DefaultAPI.DeployProcess(context.TODO()).
+		Url(BasicTestHost).Executable(bigExecutable).Execute()
+	if err == nil || resp == nil || resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected a 413 response for a big executable, got %v (%v)", resp, err)
+	}
+
+	smallBlobPath := path.Join(t.TempDir(), "small.txt")
+	if err := os.WriteFile(smallBlobPath, []byte("small"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	smallBlob, err := os.Open(smallBlobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer smallBlob.Close()
+	uploaded, _, err := client.DefaultAPI.UploadBlobs(context.TODO()).
+		Url(BasicTestHost).Blobs([]*os.File{smallBlob}).Execute()
+	if err != nil || len(uploaded.Stored) != 1 {
+		t.Fatalf("expected a small blob to be stored, got %v (%v)", uploaded, err)
+	}
+}
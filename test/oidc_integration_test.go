@@ -0,0 +1,346 @@
+package internetgolf_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/internet-golf/internet-golf/pkg/api"
+	"github.com/internet-golf/internet-golf/pkg/db"
+	"github.com/internet-golf/internet-golf/pkg/resources"
+	"github.com/internet-golf/internet-golf/pkg/utils"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// a stand-in for a ci system's oidc issuer, which serves its public key at
+// JwksUrl and can sign tokens with the private key
+type testOidcIssuer struct {
+	server  *httptest.Server
+	key     jwk.Key
+	JwksUrl string
+	mutex   sync.Mutex
+	// how many times the keys have been requested
+	fetches atomic.Int32
+	// if this is true, requests for the keys fail like the issuer is down
+	failing atomic.Bool
+}
+
+func newTestOidcIssuer(t *testing.T) *testOidcIssuer {
+	issuer := &testOidcIssuer{key: newTestSigningKey(t, "test-key")}
+	issuer.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issuer.fetches.Add(1)
+		if issuer.failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		issuer.mutex.Lock()
+		publicKeys, err := jwk.PublicSetOf(singleKeySet(t, issuer.key))
+		issuer.mutex.Unlock()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(publicKeys)
+	}))
+	t.Cleanup(issuer.server.Close)
+	issuer.JwksUrl = issuer.server.URL + "/jwks"
+	return issuer
+}
+
+// replaces the issuer's key with a new one with a different id, like when an
+// issuer rotates its keys
+func (i *testOidcIssuer) rotateKey(t *testing.T, id string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.key = newTestSigningKey(t, id)
+}
+
+func (i *testOidcIssuer) sign(t *testing.T, claims map[string]any) string {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return signTestOidcToken(t, i.key, claims)
+}
+
+func newTestSigningKey(t *testing.T, id string) jwk.Key {
+	rawKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := jwk.FromRaw(rawKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key.Set(jwk.KeyIDKey, id)
+	key.Set(jwk.AlgorithmKey, jwa.RS256)
+	return key
+}
+
+func singleKeySet(t *testing.T, key jwk.Key) jwk.Set {
+	set := jwk.NewSet()
+	if err := set.AddKey(key); err != nil {
+		t.Fatal(err)
+	}
+	return set
+}
+
+// signs a token that expires in an hour with the given claims
+func signTestOidcToken(t *testing.T, key jwk.Key, claims map[string]any) string {
+	builder := jwt.NewBuilder().
+		IssuedAt(time.Now()).
+		Expiration(time.Now().Add(time.Hour))
+	for name, value := range claims {
+		builder = builder.Claim(name, value)
+	}
+	token, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.RS256, key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(signed)
+}
+
+func TestOidcIssuers(t *testing.T) {
+	issuer := newTestOidcIssuer(t)
+
+	config := utils.NewConfig(t.TempDir(), true, false, "0", 3, 0, time.Second)
+	config.OidcIssuers = append(config.OidcIssuers, utils.OidcIssuer{
+		Name:         string(db.Gitlab),
+		Issuer:       "https://gitlab.test",
+		JwksUrl:      issuer.JwksUrl,
+		Audience:     "golf-test",
+		RepoClaim:    "project_path",
+		RefClaim:     "ref",
+		RefTypeClaim: "ref_type",
+		ActorClaim:   "user_login",
+		ActorIdClaim: "user_id",
+	})
+	database, err := db.NewDb(config, resources.NewFileManager(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	authManager := api.NewAuthManager(database, config)
+
+	claims := func(changes map[string]any) map[string]any {
+		result := map[string]any{
+			"iss":          "https://gitlab.test",
+			"aud":          "golf-test",
+			"project_path": "group/site",
+			"ref":          "main",
+			"ref_type":     "branch",
+			"user_login":   "golfer",
+			"user_id":      "42",
+		}
+		for name, value := range changes {
+			result[name] = value
+		}
+		return result
+	}
+	permissionsFor := func(token string) (api.Permissions, error) {
+		// from somewhere other than localhost, so that the token is what counts
+		return authManager.GetPermissionsForRequest("203.0.113.7:4321", "OIDC "+token)
+	}
+	deployment := func(sourceType db.ExternalSourceType, source string) *db.Deployment {
+		return &db.Deployment{DeploymentMetadata: db.DeploymentMetadata{
+			Url:                db.Url{Domain: "site.test"},
+			ExternalSourceType: sourceType,
+			ExternalSource:     source,
+		}}
+	}
+
+	permissions, err := permissionsFor(issuer.sign(t, claims(nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checks := []struct {
+		description string
+		allowed     bool
+		expected    bool
+	}{
+		{"deploy to its repo", permissions.CanDeployToDeployment(deployment(db.Gitlab, "group/site")), true},
+		{"deploy to its branch", permissions.CanDeployToDeployment(deployment(db.Gitlab, "group/site#main")), true},
+		{"deploy to another branch", permissions.CanDeployToDeployment(deployment(db.Gitlab, "group/site#dev")), false},
+		{"deploy to another repo", permissions.CanDeployToDeployment(deployment(db.Gitlab, "group/other")), false},
+		{"deploy to a github repo with the same name", permissions.CanDeployToDeployment(deployment(db.Github, "group/site")), false},
+		{"modify its repo", permissions.CanModifyDeployment(deployment(db.Gitlab, "group/site")), false},
+		{"manage server", permissions.CanManageServer(), false},
+	}
+	for _, check := range checks {
+		if check.allowed != check.expected {
+			t.Errorf("expected %q to be %v", check.description, check.expected)
+		}
+	}
+
+	// users are registered per source, so a github user with the same id
+	// doesn't count
+	authManager.RegisterExternalUser(db.ExternalUser{ExternalId: "42", ExternalSource: db.Github, FullPermissions: true})
+	if permissions, err := permissionsFor(issuer.sign(t, claims(nil))); err != nil || permissions.CanManageServer() {
+		t.Fatalf("expected the github user to not give the gitlab user permissions (error: %v)", err)
+	}
+	authManager.RegisterExternalUser(db.ExternalUser{ExternalId: "42", ExternalSource: db.Gitlab, FullPermissions: true})
+	if permissions, err := permissionsFor(issuer.sign(t, claims(nil))); err != nil || !permissions.CanManageServer() {
+		t.Fatalf("expected the registered gitlab user to have full permissions (error: %v)", err)
+	}
+
+	// tags aren't branches
+	permissions, err = permissionsFor(issuer.sign(t, claims(map[string]any{
+		"ref": "v1.0", "ref_type": "tag", "user_id": "43",
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if permissions.CanDeployToDeployment(deployment(db.Gitlab, "group/site#v1.0")) {
+		t.Error("expected a token for a tag to not count as a token for a branch")
+	}
+
+	rejected := []struct {
+		description string
+		token       string
+	}{
+		{"wrong audience", issuer.sign(t, claims(map[string]any{"aud": "something-else"}))},
+		{"unknown issuer", issuer.sign(t, claims(map[string]any{"iss": "https://elsewhere.test"}))},
+		{"expired", issuer.sign(t, claims(map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}))},
+		{"wrong key", signTestOidcToken(t, newTestSigningKey(t, "test-key"), claims(nil))},
+		{"no repo", issuer.sign(t, claims(map[string]any{"project_path": ""}))},
+	}
+	for _, check := range rejected {
+		if _, err := permissionsFor(check.token); !errors.Is(err, api.ErrInvalidCredentials) {
+			t.Errorf("expected token with %s to be rejected, got %v", check.description, err)
+		}
+	}
+
+	// the github-specific header only accepts tokens from github
+	_, err = authManager.GetPermissionsForRequest(
+		"203.0.113.8:4321", "GithubOIDC "+issuer.sign(t, claims(nil)),
+	)
+	if !errors.Is(err, api.ErrInvalidCredentials) {
+		t.Errorf("expected a gitlab token in a GithubOIDC header to be rejected, got %v", err)
+	}
+}
+
+func TestOidcKeyCaching(t *testing.T) {
+	issuer := newTestOidcIssuer(t)
+
+	newAuthManager := func(refreshInterval time.Duration, fallback time.Duration) *api.AuthManager {
+		config := utils.NewConfig(t.TempDir(), true, false, "0", 3, 0, time.Second)
+		config.OidcKeysRefreshInterval = refreshInterval
+		config.OidcKeysFallback = fallback
+		config.OidcIssuers = []utils.OidcIssuer{{
+			Name:         string(db.Forgejo),
+			Issuer:       "https://forgejo.test",
+			JwksUrl:      issuer.JwksUrl,
+			Audience:     "golf-test",
+			RepoClaim:    "repository",
+			RefClaim:     "ref",
+			ActorClaim:   "actor",
+			ActorIdClaim: "actor_id",
+		}}
+		database, err := db.NewDb(config, resources.NewFileManager(config))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return api.NewAuthManager(database, config)
+	}
+	verify := func(authManager *api.AuthManager) error {
+		_, err := authManager.GetPermissionsForRequest("203.0.113.7:4321", "OIDC "+issuer.sign(t, map[string]any{
+			"iss": "https://forgejo.test", "aud": "golf-test", "repository": "golfer/site",
+			"ref": "refs/heads/main", "actor": "golfer", "actor_id": 7,
+		}))
+		return err
+	}
+	metricValue := func(authManager *api.AuthManager, name string, labels map[string]string) float64 {
+		families, err := authManager.Metrics().Gather()
+		if err != nil {
+			t.Fatal(err)
+		}
+		total := 0.0
+		for _, family := range families {
+			if family.GetName() != name {
+				continue
+			}
+		metrics:
+			for _, metric := range family.GetMetric() {
+				for _, label := range metric.GetLabel() {
+					if value, ok := labels[label.GetName()]; ok && value != label.GetValue() {
+						continue metrics
+					}
+				}
+				total += float64(metric.GetHistogram().GetSampleCount()) + metric.GetCounter().GetValue()
+			}
+		}
+		return total
+	}
+
+	// the keys are fetched once and then reused
+	authManager := newAuthManager(utils.DefaultOidcKeysRefreshInterval, utils.DefaultOidcKeysFallback)
+	for range 3 {
+		if err := verify(authManager); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if fetches := issuer.fetches.Load(); fetches != 1 {
+		t.Fatalf("expected the keys to be fetched once, got %d", fetches)
+	}
+
+	verifications := metricValue(authManager, "golf_oidc_verification_seconds", map[string]string{
+		"issuer": string(db.Forgejo), "result": "valid",
+	})
+	if verifications != 3 {
+		t.Fatalf("expected 3 valid verifications to be recorded, got %v", verifications)
+	}
+
+	// with a fallback, old keys keep working while the issuer is down. (the
+	// tiny refresh interval makes the keys get refreshed on every request,
+	// instead of only every once in a while)
+	authManager = newAuthManager(time.Nanosecond, time.Hour)
+	if err := verify(authManager); err != nil {
+		t.Fatal(err)
+	}
+	issuer.failing.Store(true)
+	if err := verify(authManager); err != nil {
+		t.Fatalf("expected the old keys to be used while the issuer is down, got %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for metricValue(authManager, "golf_oidc_key_fetch_failures_total", nil) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the keys to be refreshed in the background")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// a token signed with a key that isn't cached makes the keys get fetched
+	// right away instead of in the background, since the old keys won't work
+	issuer.failing.Store(false)
+	issuer.rotateKey(t, "rotated-key")
+	if err := verify(authManager); err != nil {
+		t.Fatalf("expected a token signed with a rotated key to work, got %v", err)
+	}
+
+	// without one, tokens can't be verified while the issuer is down, but
+	// that's not the client's fault
+	authManager = newAuthManager(time.Nanosecond, 0)
+	if err := verify(authManager); err != nil {
+		t.Fatal(err)
+	}
+	issuer.failing.Store(true)
+	err := verify(authManager)
+	var authErr *api.AuthError
+	if err == nil || errors.As(err, &authErr) {
+		t.Fatalf("expected a server-side error while the issuer is down, got %v", err)
+	}
+	if errors := metricValue(authManager, "golf_oidc_verification_seconds", map[string]string{"result": "error"}); errors != 1 {
+		t.Fatalf("expected the failed verification to be recorded, got %v", errors)
+	}
+}
@@ -0,0 +1,193 @@
+// tests for native process deployments. these build a tiny go web server and
+// upload it as the executable.
+
+package internetgolf_test
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/internet-golf/internet-golf/pkg/db"
+)
+
+const testProcessSource = `package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+)
+
+func main() {
+	port := os.Getenv("PORT")
+	fmt.Println("listening on port " + port)
+	http.HandleFunc("/crash", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(os.Stderr, "crashing on purpose")
+		os.Exit(1)
+	})
+	http.HandleFunc("/secret", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "secret: "+os.Getenv("GOLF_TEST_SECRET"))
+	})
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "hello from a process")
+	})
+	http.ListenAndServe("127.0.0.1:"+port, nil)
+}
+`
+
+// compiles testProcessSource and returns the path of the executable
+func buildTestProcess(t *testing.T) string {
+	buildDir, err := os.MkdirTemp("", "golf-process")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempDirs = append(tempDirs, buildDir)
+
+	sourcePath := path.Join(buildDir, "main.go")
+	if err := os.WriteFile(sourcePath, []byte(testProcessSource), 0644); err != nil {
+		t.Fatal(err)
+	}
+	executable := path.Join(buildDir, "server")
+	build := exec.Command("go", "build", "-o", executable, sourcePath)
+	if output, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("could not build test process: %v\n%s", err, output)
+	}
+	return executable
+}
+
+func TestProcessDeployment(t *testing.T) {
+	executablePath := buildTestProcess(t)
+
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	url := "http://" + BasicTestHost
+	assertUrlEmpty(url, t)
+
+	deploymentUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: deploymentUrl}); err != nil {
+		t.Fatal(err)
+	}
+	deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	executable, err := os.Open(executablePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer executable.Close()
+	if err := deploymentBus.PutProcessForDeployment(deployment, executable, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "hello from a process"
+	waitForPageContent(url, expected, 5*time.Second, t)
+
+	deployment, err = deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs, err := deploymentBus.GetProcessLogs(deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := deployment.ServedThing[strings.LastIndex(deployment.ServedThing, ":")+1:]
+	if !strings.Contains(string(logs), "listening on port "+port) {
+		t.Fatalf("expected process to be given port %s, got logs %q", port, logs)
+	}
+
+	// crash the process; it should be restarted
+	http.Get(url + "/crash")
+	waitForPageContent(url, expected, 5*time.Second, t)
+
+	logs, err = deploymentBus.GetProcessLogs(deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(logs), "crashing on purpose") {
+		t.Fatalf("expected logs to contain stderr output, got %q", logs)
+	}
+
+	// redeploying starts a new process and only then stops the old one
+	if _, err := executable.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := deploymentBus.PutProcessForDeployment(deployment, executable, ""); err != nil {
+		t.Fatal(err)
+	}
+	previous := deployment
+	deployment, err = deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deployment.ServedThing == previous.ServedThing {
+		t.Fatal("expected redeployed process to get a new address")
+	}
+	waitForPageContent(url, expected, 5*time.Second, t)
+	if _, err := http.Get("http://" + previous.ServedThing); err == nil {
+		t.Fatal("expected old process to be stopped after redeploying")
+	}
+
+	// if the new process can't be started, the old one keeps serving the site
+	if err := deploymentBus.PutProcessForDeployment(
+		deployment, strings.NewReader("not an executable"), "",
+	); err == nil {
+		t.Fatal("expected invalid executable to fail to start")
+	}
+	if content := urlToPageContent(url, t); content != expected {
+		t.Fatalf("expected %q after failed redeploy, got %q", expected, content)
+	}
+
+	// deleting the deployment should stop the process
+	if err := deploymentBus.DeleteDeployment(deploymentUrl); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := http.Get("http://" + deployment.ServedThing); err == nil {
+		t.Fatal("expected process to be stopped after deleting its deployment")
+	}
+}
+
+func TestProcessDeploymentFromArchive(t *testing.T) {
+	executablePath := buildTestProcess(t)
+	executable, err := os.ReadFile(executablePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the server's environment shouldn't be passed on to processes
+	t.Setenv("GOLF_TEST_SECRET", "hunter2")
+
+	deploymentBus := createBus()
+	defer deploymentBus.Stop()
+
+	url := "http://" + BasicTestHost
+	assertUrlEmpty(url, t)
+
+	deploymentUrl := db.Url{Domain: BasicTestHost}
+	if err := deploymentBus.SetupDeployment(db.DeploymentMetadata{Url: deploymentUrl}); err != nil {
+		t.Fatal(err)
+	}
+	deployment, err := deploymentBus.GetDeploymentByUrl(&deploymentUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the executable is the only thing in the archive, so it's in a leading
+	// directory that has to be kept for the entrypoint to be right
+	archive := tarGzFromFiles(map[string]string{"bin/server": string(executable)}, t)
+	if err := deploymentBus.PutProcessForDeployment(deployment, archive, "bin/server"); err != nil {
+		t.Fatal(err)
+	}
+	waitForPageContent(url, "hello from a process", 5*time.Second, t)
+
+	if bodyStr := urlToPageContent(url+"/secret", t); bodyStr != "secret: " {
+		t.Fatalf("expected the process to not get the server's environment, got %q", bodyStr)
+	}
+}
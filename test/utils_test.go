@@ -4,15 +4,21 @@
 package internetgolf_test
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"os/exec"
+	"path"
 	"strings"
 	"testing"
 
 	golfsdk "github.com/internet-golf/internet-golf/client-sdk"
+	"github.com/mholt/archives"
 	"github.com/txn2/txeh"
 )
 
@@ -139,3 +145,54 @@ func setupHosts() {
 		}
 	}
 }
+
+// builds a .tar.gz in memory that contains the given files (path -> contents)
+func tarGzFromFiles(files map[string]string, t *testing.T) *bytes.Reader {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tarWriter.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buffer.Bytes())
+}
+
+// builds an archive in memory in the given format that contains the given
+// files (path -> contents)
+func archiveFromFiles(files map[string]string, format archives.Archiver, t *testing.T) *bytes.Reader {
+	dir := t.TempDir()
+	for name, content := range files {
+		filePath := path.Join(dir, name)
+		if err := os.MkdirAll(path.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fileTree, err := archives.FilesFromDisk(context.Background(), nil, map[string]string{dir: ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buffer bytes.Buffer
+	if err := format.Archive(context.Background(), &buffer, fileTree); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buffer.Bytes())
+}